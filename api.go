@@ -1,14 +1,18 @@
 package dlt645
 
 import (
+	"context"
 	"fmt"
 	"github.com/shopspring/decimal"
+	"time"
 )
 
 type Value struct {
 	Name  string
 	Unit  string
 	Value decimal.Decimal
+	Data  any    // 非数值数据的解码结果, 如状态字
+	Raw   []byte // 原始数据
 	Err   error
 }
 
@@ -16,11 +20,39 @@ func (v *Value) String() string {
 	if v.Err != nil {
 		return fmt.Sprintf("%s: %s", v.Name, v.Err)
 	}
+	if v.Data != nil {
+		return fmt.Sprintf("%s: %+v", v.Name, v.Data)
+	}
 	return fmt.Sprintf("%s: %s%s", v.Name, v.Value, v.Unit)
 }
 
 type Client interface {
 	ReadAddress() (string, error)
+	ReadAddressContext(ctx context.Context) (string, error)
+	DetectProtocol(addr string) (P, error)
+	DetectProtocolContext(ctx context.Context, addr string) (P, error)
 	Read(addr string, dic DIC) []*Value
+	ReadContext(ctx context.Context, addr string, dic DIC) []*Value
 	BatchRead(addr string, dics []DIC) []*Value
+	BatchReadContext(ctx context.Context, addr string, dics []DIC) []*Value
+	ReadPoints(addr string, points ...Point) []*Value
+	ReadPointsContext(ctx context.Context, addr string, points ...Point) []*Value
+	Write(addr string, dic DIC, password *Password, data []byte) error
+	WriteContext(ctx context.Context, addr string, dic DIC, password *Password, data []byte) error
+	ReadEvents(addr string, kind EventKind, lastN int) ([]*EventRecord, error)
+	ReadRunningStatus(addr string) (*RunningStatus, error)
+	ReadActiveReportStatus(addr string) (*ActiveReportStatus, error)
+	ReadHarmonics(addr string, block DIC) (*HarmonicSpectrum, error)
+	ReadPrepaidStatus(addr string) (*PrepaidStatus, error)
+	ReadPurchaseRecords(addr string, lastN int) ([]*PurchaseRecord, error)
+	ReadTOUParams(addr string) (*TOUParams, error)
+	WriteTOUParams(addr string, password *Password, p *TOUParams) error
+	ReadTimeZoneTable(addr string, set int) ([]TimeZone, error)
+	WriteTimeZoneTable(addr string, password *Password, set int, zones []TimeZone) error
+	ReadDailyTable(addr string, set, table int) ([]TimePeriod, error)
+	WriteDailyTable(addr string, password *Password, set, table int, periods []TimePeriod) error
+	ReadHoliday(addr string, n int) (*Holiday, error)
+	WriteHoliday(addr string, password *Password, n int, h *Holiday) error
+	ReadTOUSwitchTimes(addr string) (*TOUSwitchTimes, error)
+	WriteTOUSwitchTime(addr string, password *Password, dic DIC, t time.Time) error
 }
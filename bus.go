@@ -0,0 +1,133 @@
+package dlt645
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+/*
+Priority the priority of the request on the bus, the higher priority request is sent first
+
+	@EnumConfig(noCase)
+	@Enum {
+		Background  // 后台轮询
+		Normal      // 普通读数据
+		Interactive // 交互操作
+		Control     // 控制命令, 如写数据
+	}
+*/
+type Priority int
+
+type priorityKey struct{}
+
+// WithPriority return the ctx with the priority of the request, the read default is PriorityNormal,
+// the write default is PriorityControl
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+func priorityOf(ctx context.Context, def Priority) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return def
+}
+
+type waiter struct {
+	priority Priority
+	seq      uint64
+	index    int
+	ready    chan struct{}
+}
+
+// waiters the heap of the waiters, the higher priority first, and the same priority is first in first out
+type waiters []*waiter
+
+func (w waiters) Len() int { return len(w) }
+
+func (w waiters) Less(i, j int) bool {
+	if w[i].priority != w[j].priority {
+		return w[i].priority > w[j].priority
+	}
+	return w[i].seq < w[j].seq
+}
+
+func (w waiters) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index = i
+	w[j].index = j
+}
+
+func (w *waiters) Push(x any) {
+	item := x.(*waiter)
+	item.index = len(*w)
+	*w = append(*w, item)
+}
+
+func (w *waiters) Pop() any {
+	old := *w
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	item.index = -1
+	*w = old[:len(old)-1]
+	return item
+}
+
+// bus serialize the request and response transactions of one transporter
+type bus struct {
+	lock    sync.Mutex
+	busy    bool
+	seq     uint64
+	waiters waiters
+	lastTx  time.Time // 最后一次发送的时间, 只在获得总线后访问
+	lastRx  time.Time // 最后一次收到数据的时间, 只在获得总线后访问
+	echo    *bool     // 自动检测的回显结果, nil为未检测
+	unread  []byte    // 检测回显时读到的应答数据, 在读取应答时先返回
+}
+
+// acquire wait until the bus is free for the transaction, or the ctx is done
+func (b *bus) acquire(ctx context.Context, priority Priority) error {
+	b.lock.Lock()
+	if !b.busy && len(b.waiters) == 0 {
+		b.busy = true
+		b.lock.Unlock()
+		return nil
+	}
+
+	b.seq++
+	w := &waiter{priority: priority, seq: b.seq, ready: make(chan struct{})}
+	heap.Push(&b.waiters, w)
+	b.lock.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		b.lock.Lock()
+		if w.index >= 0 {
+			heap.Remove(&b.waiters, w.index)
+			b.lock.Unlock()
+		} else {
+			// 取消的同时已经获得了总线, 交给下一个等待者
+			b.lock.Unlock()
+			b.release()
+		}
+		return ctx.Err()
+	}
+}
+
+// release give the bus to the waiter of the highest priority
+func (b *bus) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if len(b.waiters) > 0 {
+		w := heap.Pop(&b.waiters).(*waiter)
+		close(w.ready)
+		return
+	}
+
+	b.busy = false
+}
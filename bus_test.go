@@ -0,0 +1,77 @@
+package dlt645
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_Priority(t *testing.T) {
+	b := &bus{}
+	assert.NoError(t, b.acquire(context.Background(), PriorityNormal))
+
+	var lock sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	for i, p := range []Priority{PriorityBackground, PriorityNormal, PriorityControl, PriorityBackground, PriorityInteractive} {
+		wg.Add(1)
+		go func(p Priority) {
+			defer wg.Done()
+			assert.NoError(t, b.acquire(context.Background(), p))
+			lock.Lock()
+			order = append(order, p)
+			lock.Unlock()
+			b.release()
+		}(p)
+
+		// 等待进入队列, 保证同优先级的先后顺序
+		assert.Eventually(t, func() bool {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			return len(b.waiters) == i+1
+		}, time.Second, time.Millisecond)
+	}
+
+	// 取消的等待者从队列中移除
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.acquire(ctx, PriorityControl), context.DeadlineExceeded)
+	assert.Len(t, b.waiters, 5)
+
+	b.release()
+	wg.Wait()
+	assert.Equal(t, []Priority{PriorityControl, PriorityInteractive, PriorityNormal, PriorityBackground, PriorityBackground}, order)
+	assert.False(t, b.busy)
+}
+
+func TestBus_ConcurrentRead(t *testing.T) {
+	tr := newTestTransporter(t, func(req []byte) [][]byte {
+		f, err := NewFrameByRespHeader(req[:FRAME_HEADER_LEN])
+		if err != nil {
+			return nil
+		}
+		// 应答中带上请求的地址, 交错的事务会导致地址不匹配
+		return [][]byte{testFrame(f.GetAddress(), 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 0)}
+	})
+
+	// 共享同一个总线的多个客户端
+	clients := []Client{NewClient(tr, WithPreambles(0)), NewClient(tr, WithPreambles(0))}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := WithPriority(context.Background(), Priority(i%4))
+			addr := string(rune('1' + i%9))
+			v := clients[i%2].ReadContext(ctx, addr, DICPhaseAVoltage)
+			assert.NoError(t, v[0].Err)
+			assert.Equal(t, "230", v[0].Value.String())
+		}(i)
+	}
+	wg.Wait()
+	assert.False(t, tr.bus.busy)
+}
@@ -2,27 +2,65 @@ package dlt645
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"github.com/expgo/factory"
-	"github.com/shopspring/decimal"
+	"github.com/expgo/log"
+	"os"
+	"sync"
+	"time"
 )
 
 type client struct {
+	log.InnerLog
 	Protocol    P
 	transporter Transporter
+	timeout     time.Duration
+	preambles   int
+	retry       RetryPolicy
+	location    *time.Location
+	protocols   map[string]P
+	protoLock   sync.RWMutex
 }
 
-func NewClient(transporter Transporter) Client {
-	return &client{
+func NewClient(transporter Transporter, opts ...ClientOption) Client {
+	c := &client{
 		Protocol:    PV2007,
 		transporter: transporter,
+		preambles:   PRE_BYTE_LEN,
+		location:    time.Local,
+		protocols:   map[string]P{},
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.L == nil {
+		c.L = log.New(c)
+	}
+
+	return c
+}
+
+// protocol return the protocol of the meter address, the override of WithAddressProtocol first
+func (c *client) protocol(addr string) P {
+	c.protoLock.RLock()
+	defer c.protoLock.RUnlock()
+
+	if p, ok := c.protocols[addr]; ok {
+		return p
+	}
+	return c.Protocol
 }
 
-func (c *client) writeFrame(f *Frame) error {
+// writeFrame write the frame after the bus is idle for the timing of the transporter,
+// and read back the local echo of the half-duplex adapter
+func (c *client) writeFrame(ctx context.Context, f *Frame) error {
 	var buf bytes.Buffer
 
-	for i := 0; i < PRE_BYTE_LEN; i++ {
+	for i := 0; i < c.preambles; i++ {
 		_ = buf.WriteByte(PRE_BYTE)
 	}
 
@@ -31,22 +69,171 @@ func (c *client) writeFrame(f *Frame) error {
 		return err
 	}
 
+	b := c.transporter.getBus()
+	if err = b.waitIdle(ctx, c.transporter.getTiming()); err != nil {
+		return err
+	}
+
 	_, err = c.transporter.Write(buf.Bytes())
+	b.lastTx = time.Now()
 	if err != nil {
 		return err
 	}
 
+	return c.readEcho(ctx, buf.Bytes())
+}
+
+// readEcho read and verify the echo of the sent bytes. when the echo mode is auto and the echo is not detected,
+// the first different byte means no echo, and the read bytes are kept for reading the response
+func (c *client) readEcho(ctx context.Context, sent []byte) error {
+	b := c.transporter.getBus()
+	mode := c.transporter.getEchoMode()
+	if mode == EchoModeOff || (mode == EchoModeAuto && b.echo != nil && !*b.echo) {
+		return nil
+	}
+
+	deadline := time.Now().Add(c.frameTimeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	detect := mode == EchoModeAuto && b.echo == nil
+	buf := make([]byte, len(sent))
+	for n := 0; n < len(sent); n++ {
+		if err := c.read(ctx, buf[n:n+1], deadline, 0); err != nil {
+			return err
+		}
+
+		if buf[n] != sent[n] {
+			if detect {
+				echo := false
+				b.echo = &echo
+				b.unread = append(b.unread, buf[:n+1]...)
+				c.L.Infof("transporter has no local echo")
+				return nil
+			}
+			return fmt.Errorf("%w: % X of % X", ErrEcho, buf[:n+1], sent)
+		}
+	}
+
+	if detect {
+		echo := true
+		b.echo = &echo
+		c.L.Infof("transporter has local echo")
+	}
+
 	return nil
 }
 
-func (c *client) readFrame() (*Frame, error) {
-	respBuf := make([]byte, FRAME_HEADER_LEN+PRE_BYTE_LEN)
-	_, err := c.transporter.Read(respBuf)
-	if err != nil {
+// read fill the whole buf before the deadline of the request, return the ctx error if the ctx is done.
+// the interByte is the max interval of the bytes in the frame, 0 is no limit
+func (c *client) read(ctx context.Context, buf []byte, deadline time.Time, interByte time.Duration) error {
+	b := c.transporter.getBus()
+	for n := 0; n < len(buf); {
+		if len(b.unread) > 0 {
+			m := copy(buf[n:], b.unread)
+			b.unread = b.unread[m:]
+			n += m
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		remain := time.Until(deadline)
+		if remain <= 0 {
+			return os.ErrDeadlineExceeded
+		}
+		if interByte > 0 && interByte < remain {
+			remain = interByte
+		}
+		c.transporter.setReadTimeout(remain)
+
+		m, err := c.transporter.Read(buf[n:])
+		if m > 0 {
+			b.lastRx = time.Now()
+		}
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			// 读的截止时间就是ctx的截止时间时, 读超时可能早于ctx的超时
+			if d, ok := ctx.Deadline(); ok && !time.Now().Before(d) {
+				return context.DeadlineExceeded
+			}
+			if interByte > 0 && time.Since(b.lastRx) >= interByte {
+				return fmt.Errorf("inter-byte timeout %s: %w", interByte, err)
+			}
+			return err
+		}
+		n += m
+	}
+
+	return nil
+}
+
+// readResponse read the response of the request f until the timeout, the stale or foreign frame is discarded
+func (c *client) readResponse(ctx context.Context, f *Frame, echo []byte) (*Frame, error) {
+	deadline := time.Now().Add(c.frameTimeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	for {
+		resp, err := c.readFrame(ctx, deadline)
+		if resp == nil {
+			return nil, err
+		}
+
+		if mErr := f.MatchResponse(resp, echo); mErr != nil {
+			c.L.Debugf("discard frame: %v", mErr)
+			continue
+		}
+
+		return resp, err
+	}
+}
+
+// frameTimeout return the timeout of WithTimeout, the FrameTimeout of the transporter timing, or the ReadTimeout of
+// the transporter without the timing, such as the tcp and the dtu whose round trip may be longer than one second
+func (c *client) frameTimeout() time.Duration {
+	if c.timeout > 0 {
+		return c.timeout
+	}
+	if timeout := c.transporter.getTiming().FrameTimeout; timeout > 0 {
+		return timeout
+	}
+	if timeout := c.transporter.getReadTimeout(); timeout > 0 {
+		return timeout
+	}
+	return DefaultResponseTimeout * time.Millisecond
+}
+
+// readFrame read a frame until the deadline, the frame is returned with the error response of the meter
+func (c *client) readFrame(ctx context.Context, deadline time.Time) (*Frame, error) {
+	interByte := c.transporter.getTiming().InterByteTimeout
+	// 跳过前导字节, 应答帧的前导字节数量不固定
+	var start [1]byte
+	for i := 0; ; i++ {
+		if err := c.read(ctx, start[:], deadline, 0); err != nil {
+			return nil, err
+		}
+		if start[0] != PRE_BYTE {
+			break
+		}
+		if i >= MaxPreambles {
+			return nil, errors.New("too many preamble bytes")
+		}
+	}
+
+	header := make([]byte, FRAME_HEADER_LEN)
+	header[0] = start[0]
+	if err := c.read(ctx, header[1:], deadline, interByte); err != nil {
 		return nil, err
 	}
 
-	f, err := NewFrameByRespHeader(respBuf)
+	f, err := NewFrameByRespHeader(header)
 	if err != nil {
 		return nil, err
 	}
@@ -57,29 +244,115 @@ func (c *client) readFrame() (*Frame, error) {
 
 	if f.L > 0 {
 		respData := make([]byte, f.L)
-		_, err = c.transporter.Read(respData)
-		if err != nil {
+		if err = c.read(ctx, respData, deadline, interByte); err != nil {
 			return nil, err
 		}
 		f.Data = respData
 	}
 
 	var endBuf [2]byte
-	_, err = c.transporter.Read(endBuf[:])
-	if err != nil {
+	if err = c.read(ctx, endBuf[:], deadline, interByte); err != nil {
 		return nil, err
 	}
 	f.CS = endBuf[0]
 	f.End = endBuf[1]
 
 	if err = f.CheckEndError(); err != nil {
+		var frameErr *FrameError
+		if errors.As(err, &frameErr) {
+			return f, err
+		}
 		return nil, err
 	}
 
 	return f, nil
 }
 
+// drain discard the remaining bytes of the failed request on the bus, until the bus is idle for DrainIdleTime,
+// so the next request starts cleanly
+func (c *client) drain() {
+	c.transporter.getBus().unread = nil
+
+	buf := make([]byte, 256)
+	deadline := time.Now().Add(MaxDrainTime)
+	for time.Now().Before(deadline) {
+		c.transporter.setReadTimeout(DrainIdleTime)
+		n, err := c.transporter.Read(buf)
+		if err != nil || n == 0 {
+			return
+		}
+		c.transporter.getBus().lastRx = time.Now()
+		c.L.Debugf("drain %d bytes: % X", n, buf[:n])
+	}
+}
+
+// request send the frame and read the response, the normal response must start with echo. retry by the RetryPolicy if the request failed.
+// the blocking read is aborted when the ctx is done. the transactions of the same transporter are serialized,
+// and the waiting transaction of higher priority goes first, the priority of the ctx overrides the default priority
+func (c *client) request(ctx context.Context, f *Frame, echo []byte, priority Priority) (resp *Frame, err error) {
+	b := c.transporter.getBus()
+	if err = b.acquire(ctx, priorityOf(ctx, priority)); err != nil {
+		return nil, err
+	}
+	defer b.release()
+
+	// 取消时中断请求的读, 请求返回前等待中断的协程退出, 避免中断下一个请求的读
+	c.transporter.resetAbort()
+	done, exited := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(exited)
+		select {
+		case <-ctx.Done():
+			c.transporter.abortRead()
+		case <-done:
+		}
+	}()
+	defer func() {
+		close(done)
+		<-exited
+	}()
+
+	addr := f.GetAddress()
+	attempts := c.retry.maxAttempts()
+	for i := 1; ; i++ {
+		start := time.Now()
+		if err = c.writeFrame(ctx, f); err == nil {
+			resp, err = c.readResponse(ctx, f, echo)
+		}
+
+		retry := err != nil && i < attempts && ctx.Err() == nil && c.retry.retryable(err)
+		if c.retry.OnAttempt != nil {
+			c.retry.OnAttempt(AttemptStat{Address: addr, Attempt: i, Duration: time.Since(start), Err: err, Retry: retry})
+		}
+
+		if err == nil {
+			return resp, nil
+		}
+		resp = nil
+
+		// 丢弃失败请求的残余应答, 避免被当作下一个请求的应答
+		var frameErr *FrameError
+		if !errors.As(err, &frameErr) {
+			c.drain()
+		}
+
+		if !retry {
+			return nil, err
+		}
+
+		c.L.Debugf("retry %d request of %s: %v", i, addr, err)
+
+		if waitErr := c.retry.wait(ctx, i); waitErr != nil {
+			return nil, fmt.Errorf("%w, last attempt: %w", waitErr, err)
+		}
+	}
+}
+
 func (c *client) ReadAddress() (string, error) {
+	return c.ReadAddressContext(context.Background())
+}
+
+func (c *client) ReadAddressContext(ctx context.Context) (string, error) {
 	f := factory.New[Frame]()
 	f.C = NewCode(CRDA)
 	if err := f.SetAddress("", true); err != nil {
@@ -87,54 +360,95 @@ func (c *client) ReadAddress() (string, error) {
 	}
 	f.CalcCS()
 
-	if err := c.writeFrame(f); err != nil {
+	respFrame, err := c.request(ctx, f, nil, PriorityNormal)
+	if err != nil {
 		return "", err
 	}
 
-	respFrame, err := c.readFrame()
+	return respFrame.GetAddress(), nil
+}
+
+// readData send read frame of dic, and return the response data without dic code
+func (c *client) readData(addr string, dic DIC) ([]byte, error) {
+	return c.readProtocolData(context.Background(), addr, dic, c.protocol(addr))
+}
+
+func (c *client) readProtocolData(ctx context.Context, addr string, dic DIC, protocol P) ([]byte, error) {
+	f, err := NewReadFrame(addr, dic, protocol)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return respFrame.GetAddress(), nil
+	code := dic.Code(protocol)
+	respFrame, err := c.request(ctx, f, code, PriorityNormal)
+	if err != nil {
+		return nil, err
+	}
+
+	return respFrame.Data[len(code):], nil
 }
 
-func (c *client) getValue(buf []byte, dic DIC) (rets []*Value) {
-	code := dic.Code(c.Protocol)
+// writeData send write frame of dic, the normal response has no data
+func (c *client) writeData(addr string, dic DIC, password *Password, data []byte) error {
+	return c.writeDataContext(context.Background(), addr, dic, password, data)
+}
 
-	if !bytes.Equal(buf[:len(code)], code) {
-		return c.getErrorValues(dic, errors.New("dic code not equals"))
+func (c *client) writeDataContext(ctx context.Context, addr string, dic DIC, password *Password, data []byte) error {
+	f, err := NewWriteFrame(addr, dic, c.protocol(addr), password, data)
+	if err != nil {
+		return err
 	}
 
-	buf = buf[len(code):]
-	_, dics := dic.CheckBlock(c.Protocol)
+	_, err = c.request(ctx, f, nil, PriorityControl)
+	return err
+}
+
+func (c *client) getValue(buf []byte, dic DIC, protocol P) (rets []*Value) {
+	_, dics := dic.CheckBlock(protocol)
 
 	for _, vDIC := range dics {
 		v := &Value{}
-		v.Name = vDIC.Name()
-		v.Unit = vDIC.Unit()
+		a := vDIC.attr()
+		v.Name = a.name
+		v.Unit = a.unit
+
+		if vDIC.IsRaw(protocol) {
+			v.Raw = buf
+			v.Data = buf
+			rets = append(rets, v)
+			break
+		}
+
+		size := vDIC.Size(protocol)
+		if len(buf) < size {
+			v.Err = fmt.Errorf("%s data length %d is less than %d", a.name, len(buf), size)
+			rets = append(rets, v)
+			buf = nil
+			continue
+		}
 
-		scale := vDIC.Scale(c.Protocol)
-		value := bcdToUint(buf, vDIC.Size(c.Protocol))
-		if scale == 0 {
-			v.Value = decimal.NewFromUint64(value)
+		v.Raw = buf[:size]
+
+		if decode, ok := vDIC.decoder(); ok {
+			v.Data, v.Err = decode(v.Raw)
 		} else {
-			v.Value = decimal.New(int64(value), -int32(scale))
+			v.Value = vDIC.Decode(buf, protocol)
 		}
 
 		rets = append(rets, v)
-		buf = buf[vDIC.Size(c.Protocol):]
+		buf = buf[size:]
 	}
 
 	return rets
 }
 
-func (c *client) getErrorValues(dic DIC, err error) (rets []*Value) {
-	_, dics := dic.CheckBlock(c.Protocol)
+func (c *client) getErrorValues(dic DIC, protocol P, err error) (rets []*Value) {
+	_, dics := dic.CheckBlock(protocol)
 	for _, vDIC := range dics {
+		a := vDIC.attr()
 		rets = append(rets, &Value{
-			Name: vDIC.Name(),
-			Unit: vDIC.Unit(),
+			Name: a.name,
+			Unit: a.unit,
 			Err:  err,
 		})
 	}
@@ -143,26 +457,35 @@ func (c *client) getErrorValues(dic DIC, err error) (rets []*Value) {
 }
 
 func (c *client) Read(addr string, dic DIC) []*Value {
-	f, err := NewReadFrame(addr, dic, c.Protocol)
-	if err != nil {
-		return c.getErrorValues(dic, err)
-	}
+	return c.ReadContext(context.Background(), addr, dic)
+}
 
-	if err1 := c.writeFrame(f); err1 != nil {
-		return c.getErrorValues(dic, err1)
+func (c *client) ReadContext(ctx context.Context, addr string, dic DIC) []*Value {
+	protocol := c.protocol(addr)
+	data, err := c.readProtocolData(ctx, addr, dic, protocol)
+	if err != nil {
+		return c.getErrorValues(dic, protocol, err)
 	}
 
-	respFrame, err1 := c.readFrame()
-	if err1 != nil {
-		return c.getErrorValues(dic, err1)
-	}
+	return c.getValue(data, dic, protocol)
+}
 
-	return c.getValue(respFrame.Data, dic)
+func (c *client) BatchRead(addr string, dics []DIC) []*Value {
+	return c.BatchReadContext(context.Background(), addr, dics)
 }
 
-func (c *client) BatchRead(addr string, dics []DIC) (values []*Value) {
+// BatchReadContext read the dics one by one, the remaining dics return the ctx error when the ctx is done
+func (c *client) BatchReadContext(ctx context.Context, addr string, dics []DIC) (values []*Value) {
 	for _, dic := range dics {
-		values = append(values, c.Read(addr, dic)...)
+		values = append(values, c.ReadContext(ctx, addr, dic)...)
 	}
 	return values
 }
+
+func (c *client) Write(addr string, dic DIC, password *Password, data []byte) error {
+	return c.writeDataContext(context.Background(), addr, dic, password, data)
+}
+
+func (c *client) WriteContext(ctx context.Context, addr string, dic DIC, password *Password, data []byte) error {
+	return c.writeDataContext(ctx, addr, dic, password, data)
+}
@@ -1,12 +1,23 @@
 package dlt645
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
-func TestTcpClient_ReadAddress(t *testing.T) {
-	transport := NewTcpTransport("127.0.0.1:3330")
+const testMeterAddr = "240727263614"
+
+func TestClient_ReadAddress(t *testing.T) {
+	req := &Frame{Start: FrameStartByte, AddrEnd: FrameStartByte, C: NewCode(CRDA), End: FrameEndByte}
+	assert.NoError(t, req.SetAddress("", true))
+	req.CalcCS()
+
+	meter := &Frame{C: NewCode(CRDA)}
+	assert.NoError(t, meter.SetAddress(testMeterAddr, false))
+
+	transport := NewMockTransporter().Expect(req.Bytes(), MockResponse(meter, meter.Address[:]))
 	defer transport.Close()
 
 	c := NewClient(transport)
@@ -16,12 +27,21 @@ func TestTcpClient_ReadAddress(t *testing.T) {
 
 	addr, err := c.ReadAddress()
 	assert.NoError(t, err)
+	assert.Equal(t, testMeterAddr, addr)
+	assert.NoError(t, transport.Verify())
+}
 
-	t.Logf("addr: %s", addr)
+// expectMeterRead add the expectations of reading the dics of the test meter
+func expectMeterRead(transport *MockTransporter) {
+	transport.
+		ExpectRead(testMeterAddr, DICTotalActiveEnergy, PV2007, []byte{0x56, 0x34, 0x12, 0x00}).
+		ExpectRead(testMeterAddr, DICVoltage, PV2007, []byte{0x01, 0x23, 0x02, 0x23, 0x03, 0x23}).
+		ExpectRead(testMeterAddr, DICFrequency, PV2007, []byte{0x00, 0x50})
 }
 
-func TestTcpClient_Read(t *testing.T) {
-	transport := NewTcpTransport("127.0.0.1:3330")
+func TestClient_Read(t *testing.T) {
+	transport := NewMockTransporter()
+	expectMeterRead(transport)
 	defer transport.Close()
 
 	c := NewClient(transport)
@@ -29,36 +49,27 @@ func TestTcpClient_Read(t *testing.T) {
 	err := transport.Open()
 	assert.NoError(t, err)
 
-	v := c.Read("240727263614", DICTotalActiveEnergy)
-	t.Logf("value: %+v", v)
-
-	v = c.Read("240727263614", DICPositiveTotalActiveEnergy)
-	t.Logf("value: %+v", v)
-
-	v = c.Read("240727263614", DICNegativeTotalActiveEnergy)
-	t.Logf("value: %+v", v)
-
-	v = c.Read("240727263614", DICVoltage)
-	t.Logf("value: %+v", v)
-
-	v = c.Read("240727263614", DICCurrent)
-	t.Logf("value: %+v", v)
+	v := c.Read(testMeterAddr, DICTotalActiveEnergy)
+	assert.Len(t, v, 1)
+	assert.Equal(t, "1234.56kWh", v[0].Value.String()+v[0].Unit)
 
-	v = c.Read("240727263614", DICActivePower)
-	t.Logf("value: %+v", v)
+	v = c.Read(testMeterAddr, DICVoltage)
+	assert.Len(t, v, 3)
+	assert.Equal(t, "PhaseCVoltage", v[2].Name)
+	assert.Equal(t, "230.3V", v[2].Value.String()+v[2].Unit)
 
-	v = c.Read("240727263614", DICReactivePower)
-	t.Logf("value: %+v", v)
+	v = c.Read(testMeterAddr, DICFrequency)
+	assert.Equal(t, "50Hz", v[0].Value.String()+v[0].Unit)
 
-	v = c.Read("240727263614", DICFrequency)
-	t.Logf("value: %+v", v)
-
-	v = c.Read("240727263614", DICLineVoltage)
-	t.Logf("value: %+v", v)
+	// 没有预期的请求, 电表不应答
+	v = c.Read(testMeterAddr, DICCurrent)
+	assert.Error(t, v[0].Err)
+	assert.Error(t, transport.Verify())
 }
 
-func TestTcpClient_BatchRead(t *testing.T) {
-	transport := NewTcpTransport("127.0.0.1:3330")
+func TestClient_BatchRead(t *testing.T) {
+	transport := NewMockTransporter()
+	expectMeterRead(transport)
 	defer transport.Close()
 
 	c := NewClient(transport)
@@ -66,7 +77,91 @@ func TestTcpClient_BatchRead(t *testing.T) {
 	err := transport.Open()
 	assert.NoError(t, err)
 
-	v := c.BatchRead("240727263614", []DIC{DICTotalActiveEnergy, DICPositiveTotalActiveEnergy, DICNegativeTotalActiveEnergy,
-		DICVoltage, DICCurrent, DICActivePower, DICReactivePower, DICFrequency, DICLineVoltage})
-	t.Logf("value: %+v", v)
+	v := c.BatchRead(testMeterAddr, []DIC{DICTotalActiveEnergy, DICVoltage, DICFrequency})
+	assert.Len(t, v, 5)
+	for _, value := range v {
+		assert.NoError(t, value.Err)
+	}
+	assert.NoError(t, transport.Verify())
+}
+
+func TestClient_ReadContext(t *testing.T) {
+	tr := newTestTransporter(t, noResponse)
+	c := NewClient(tr, WithTimeout(time.Minute))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	v := c.ReadContext(ctx, "1", DICVoltage)
+	assert.Len(t, v, 3)
+	assert.ErrorIs(t, v[0].Err, context.Canceled)
+
+	// 超时后残留的应答被清空, 下一次请求读到的是自己的应答
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	v = c.ReadContext(ctx, "1", DICPhaseAVoltage)
+	assert.ErrorIs(t, v[0].Err, context.DeadlineExceeded)
+
+	tr.Inject(testFrame("1", 0x91, append(DICPhaseBVoltage.Code(PV2007), 0x01, 0x23), 4))
+	tr.Inject(testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x02, 0x23), 4))
+	c.(*client).drain()
+	tr.Inject(testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x03, 0x23), 4))
+	v = c.BatchReadContext(context.Background(), "1", []DIC{DICPhaseAVoltage})
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230.3", v[0].Value.String())
+}
+
+func TestClient_DiscardMismatch(t *testing.T) {
+	tr := newTestTransporter(t, noResponse)
+	c := NewClient(tr, WithPreambles(0))
+
+	// 迟到的应答和其他电表的应答被丢弃
+	tr.Inject(testFrame("1", 0x91, append(DICPhaseBVoltage.Code(PV2007), 0x01, 0x23), 0))
+	tr.Inject(testFrame("2", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x02, 0x23), 0))
+	tr.Inject(testFrame("1", 0xD1, []byte{byte(ErrorCodeDATA)}, 0))
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.ErrorIs(t, v[0].Err, ErrorCodeDATA)
+
+	tr.Inject(testFrame("1", 0x94, nil, 0))
+	tr.Inject(testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x03, 0x23), 0))
+	v = c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230.3", v[0].Value.String())
+}
+
+func TestClient_Echo(t *testing.T) {
+	respond := func(req []byte) [][]byte {
+		return [][]byte{testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 4)}
+	}
+
+	// 自动检测到回显, 半双工适配器回显发送的数据
+	tr := newTestTransporter(t, func(req []byte) [][]byte {
+		return append([][]byte{req}, respond(req)...)
+	})
+	tr.EchoMode = EchoModeAuto
+	c := NewClient(tr)
+	for i := 0; i < 2; i++ {
+		v := c.Read("1", DICPhaseAVoltage)
+		assert.NoError(t, v[0].Err)
+		assert.Equal(t, "230", v[0].Value.String())
+	}
+	assert.True(t, *tr.bus.echo)
+
+	// 自动检测到没有回显, 检测时读到的应答数据不丢失
+	tr = newTestTransporter(t, respond)
+	tr.EchoMode = EchoModeAuto
+	c = NewClient(tr)
+	for i := 0; i < 2; i++ {
+		v := c.Read("1", DICPhaseAVoltage)
+		assert.NoError(t, v[0].Err)
+		assert.Equal(t, "230", v[0].Value.String())
+	}
+	assert.False(t, *tr.bus.echo)
+
+	// 回显与发送的数据不一致
+	tr = newTestTransporter(t, respond)
+	tr.EchoMode = EchoModeOn
+	c = NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.ErrorIs(t, v[0].Err, ErrEcho)
 }
@@ -3,7 +3,9 @@ package dlt645
 import (
 	"encoding/binary"
 	"fmt"
+	"github.com/shopspring/decimal"
 	"strings"
+	"time"
 )
 
 // @EnumConfig(noCamel)
@@ -12,8 +14,13 @@ import (
 const (
 	MaxReadLen             = 200 // 读数据的最大数据长度
 	MaxWriteLen            = 50  // 写数据的最大数据长度
+	MaxWriteLen2007        = 200 // 2007写数据的最大数据长度, 时区表等写数据超过50字节
+	MaxWriteLen1997        = 50  // 1997写数据的最大数据长度
 	DefaultResponseTimeout = 500 // 500ms
 	MaxDeviceNameLen       = 10  // 最大设备名长度
+
+	DrainIdleTime = 50 * time.Millisecond // 清空总线时, 总线空闲多久认为没有残留数据
+	MaxDrainTime  = time.Second           // 清空总线的最长时间
 )
 
 /*
@@ -76,123 +83,495 @@ ErrorCode
 */
 type ErrorCode byte
 
+// Error implement the error interface, so the ErrorCode can be used by errors.Is
+func (x ErrorCode) Error() string {
+	return x.Msg()
+}
+
 /*
-DIC data identification code. the old is 1997 code, the val is 2007 code
+DIC data identification code. the old is 1997 code, the val is 2007 code.
+the size of 0 means the protocol unsupport the dic, the 1997 only dic val is 0xFFFF0000 | old
 
 	@EnumConfig(noCase, Values)
-	@Enum(old uint16, oldFormat string, oldSize int, newFormat string, newSize int, unit string) {
+	@Enum(old uint16, oldFormat string, oldSize int, newFormat string, newSize int, unit string, signed bool) {
 		// 电能量数据标识
-		TotalActiveEnergy             (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh")	= 0x00000000 // 组合有功总电能
-		PositiveTotalActiveEnergy     (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh")	= 0x00010000 // 正向有功总电能
-		NegativeTotalActiveEnergy     (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh")	= 0x00020000 // 反向有功总电能
-		TotalReactiveEnergy1          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00030000 // 组合无功1总电能
-		TotalReactiveEnergy2          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00040000 // 组合无功2总电能
-		FirstQuadrantReactiveEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00050000 // 第一象限无功电能
-		SecondQuadrantReactiveEnergy  (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00060000 // 第二象限无功电能
-		ThirdQuadrantReactiveEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00070000 // 第三象限无功电能
-		FourthQuadrantReactiveEnergy  (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh")	= 0x00080000 // 第四象限无功电能
-		PositiveTotalApparentEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVAh")	= 0x00090000 // 正向视在总电能
-		NegativeTotalApparentEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVAh")	= 0x000A0000 // 反向视在总电能
-		AssociatedTotalElectricEnergy (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVh")	= 0x00800000 // 关联总电能
+		TotalActiveEnergy             (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh", true)	= 0x00000000 // 组合有功总电能
+		PositiveTotalActiveEnergy     (0x9010, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00010000 // 正向有功总电能
+		PositiveActiveEnergyRate1     (0x9011, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00010100 // 正向有功费率1电能
+		PositiveActiveEnergyRate2     (0x9012, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00010200 // 正向有功费率2电能
+		PositiveActiveEnergyRate3     (0x9013, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00010300 // 正向有功费率3电能
+		PositiveActiveEnergyRate4     (0x9014, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00010400 // 正向有功费率4电能
+		PositiveActiveEnergy          (0x901F, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x0001FF00 // 正向有功电能数据块
+		NegativeTotalActiveEnergy     (0x9020, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00020000 // 反向有功总电能
+		NegativeActiveEnergyRate1     (0x9021, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00020100 // 反向有功费率1电能
+		NegativeActiveEnergyRate2     (0x9022, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00020200 // 反向有功费率2电能
+		NegativeActiveEnergyRate3     (0x9023, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00020300 // 反向有功费率3电能
+		NegativeActiveEnergyRate4     (0x9024, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x00020400 // 反向有功费率4电能
+		NegativeActiveEnergy          (0x902F, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kWh", false)	= 0x0002FF00 // 反向有功电能数据块
+		TotalReactiveEnergy1          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00030000 // 组合无功1总电能
+		ReactiveEnergy1Rate1          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00030100 // 组合无功1费率1电能
+		ReactiveEnergy1Rate2          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00030200 // 组合无功1费率2电能
+		ReactiveEnergy1Rate3          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00030300 // 组合无功1费率3电能
+		ReactiveEnergy1Rate4          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00030400 // 组合无功1费率4电能
+		ReactiveEnergy1               (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x0003FF00 // 组合无功1电能数据块
+		TotalReactiveEnergy2          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00040000 // 组合无功2总电能
+		ReactiveEnergy2Rate1          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00040100 // 组合无功2费率1电能
+		ReactiveEnergy2Rate2          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00040200 // 组合无功2费率2电能
+		ReactiveEnergy2Rate3          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00040300 // 组合无功2费率3电能
+		ReactiveEnergy2Rate4          (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x00040400 // 组合无功2费率4电能
+		ReactiveEnergy2               (0xFFFF, "", 0, "XXXXXX.XX", 4, "kvarh", true)	= 0x0004FF00 // 组合无功2电能数据块
+		FirstQuadrantReactiveEnergy   (0x9130, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kvarh", false)	= 0x00050000 // 第一象限无功电能
+		SecondQuadrantReactiveEnergy  (0x9150, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kvarh", false)	= 0x00060000 // 第二象限无功电能
+		ThirdQuadrantReactiveEnergy   (0x9160, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kvarh", false)	= 0x00070000 // 第三象限无功电能
+		FourthQuadrantReactiveEnergy  (0x9140, "XXXXXX.XX", 4, "XXXXXX.XX", 4, "kvarh", false)	= 0x00080000 // 第四象限无功电能
+		PositiveTotalApparentEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVAh", false)	= 0x00090000 // 正向视在总电能
+		NegativeTotalApparentEnergy   (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVAh", false)	= 0x000A0000 // 反向视在总电能
+		AssociatedTotalElectricEnergy (0xFFFF, "", 0, "XXXXXX.XX", 4, "KVh", false)	= 0x00800000 // 关联总电能
+		RemainingEnergy               (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh", false)	= 0x00900100 // 当前剩余电量
+		OverdraftEnergy               (0xFFFF, "", 0, "XXXXXX.XX", 4, "kWh", false)	= 0x00900101 // 当前透支电量
+		RemainingAmount               (0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x00900200 // 当前剩余金额
+		OverdraftAmount               (0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x00900201 // 当前透支金额
+
+		// 需量数据标识
+		PositiveActiveMaxDemand 		(0xA010, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01010000 // 正向有功总最大需量及发生时间
+		PositiveActiveMaxDemandRate1 	(0xA011, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01010100 // 正向有功费率1最大需量及发生时间
+		PositiveActiveMaxDemandRate2 	(0xA012, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01010200 // 正向有功费率2最大需量及发生时间
+		PositiveActiveMaxDemandRate3 	(0xA013, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01010300 // 正向有功费率3最大需量及发生时间
+		PositiveActiveMaxDemandRate4 	(0xA014, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01010400 // 正向有功费率4最大需量及发生时间
+		PositiveActiveMaxDemandBlock 	(0xA01F, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x0101FF00 // 正向有功最大需量数据块
+		NegativeActiveMaxDemand 		(0xA020, "XX.XXXX", 3, "XX.XXXX,YYMMDDhhmm", 8, "kW", false)	= 0x01020000 // 反向有功总最大需量及发生时间
+		ReactiveMaxDemand1 				(0xFFFF, "", 0, "XX.XXXX,YYMMDDhhmm", 8, "kvar", true)	= 0x01030000 // 组合无功1总最大需量及发生时间
+		ReactiveMaxDemand2 				(0xFFFF, "", 0, "XX.XXXX,YYMMDDhhmm", 8, "kvar", true)	= 0x01040000 // 组合无功2总最大需量及发生时间
 
 		// 变量数据标识
-		PhaseAVoltage 		(0xB611, "XXX", 2, "XXX.X", 2, "V")			= 0x02010100 // A相电压
-		PhaseBVoltage 		(0xB612, "XXX", 2, "XXX.X", 2, "V")			= 0x02010200 // B相电压
-		PhaseCVoltage 		(0xB613, "XXX", 2, "XXX.X", 2, "V")			= 0x02010300 // C相电压
-		Voltage       		(0xFFFF, "", 0, "XXX.X", 2, "V")			= 0x0201FF00 // 电压数据块
-		PhaseACurrent 		(0xB621, "XX.XX", 2, "XXX.XXX", 3, "A")		= 0x02020100 // A相电流
-		PhaseBCurrent 		(0xB622, "XX.XX", 2, "XXX.XXX", 3, "A")		= 0x02020200 // B相电流
-		PhaseCCurrent 		(0xB623, "XX.XX", 2, "XXX.XXX", 3, "A")		= 0x02020300 // C相电流
-		Current       		(0xFFFF, "", 0, "XXX.XXX", 3, "A")			= 0x0202FF00 // 电流数据块
-		TotalActivePower  	(0xB630, "XX.XXXX", 3, "XX.XXXX", 3, "kW")	= 0x02030000 // 总有功功率
-		PhaseAActivePower 	(0xB631, "XX.XXXX", 3, "XX.XXXX", 3, "kW")	= 0x02030100 // A相有功功率
-		PhaseBActivePower 	(0xB632, "XX.XXXX", 3, "XX.XXXX", 3, "kW")	= 0x02030200 // B相有功功率
-		PhaseCActivePower 	(0xB633, "XX.XXXX", 3, "XX.XXXX", 3, "kW")	= 0x02030300 // C相有功功率
-		ActivePower       	(0xFFFF, "", 0, "XX.XXXX", 3, "kW")			= 0x0203FF00 // 有功功率数据块
-		TotalReactivePower  (0xB640, "", 0, "XX.XXXX", 3, "kvar")		= 0x02040000 // 总无功功率
-		PhaseAReactivePower (0xB641, "", 0, "XX.XXXX", 3, "kvar")		= 0x02040100 // A相无功功率
-		PhaseBReactivePower (0xB642, "", 0, "XX.XXXX", 3, "kvar")		= 0x02040200 // B相无功功率
-		PhaseCReactivePower (0xB643, "", 0, "XX.XXXX", 3, "kvar")		= 0x02040300 // C相无功功率
-		ReactivePower       (0xFFFF, "", 0, "XX.XXXX", 3, "kvar")		= 0x0204FF00 // 无功功率数据块
-		TotalApparentPower  (0xB660, "", 0, "XX.XXXX", 3, "kVA")		= 0x02050000 // 总视在功率
-		PhaseAApparentPower (0xB661, "", 0, "XX.XXXX", 3, "kVA")		= 0x02050100 // A相视在功率
-		PhaseBApparentPower (0xB662, "", 0, "XX.XXXX", 3, "kVA")		= 0x02050200 // B相视在功率
-		PhaseCApparentPower (0xB663, "", 0, "XX.XXXX", 3, "kVA")		= 0x02050300 // C相视在功率
-		ApparentPower       (0xFFFF, "", 0, "XX.XXXX", 3, "kVA")		= 0x0205FF00 // 视在功率数据块
-		TotalPowerFactor  	(0xFFFF, "", 0, "X.XXX", 2, "")				= 0x02060000 // 总功率因素
-		PhaseAPowerFactor 	(0xFFFF, "", 0, "X.XXX", 2, "")				= 0x02060100 // A相功率因素
-		PhaseBPowerFactor 	(0xFFFF, "", 0, "X.XXX", 2, "")				= 0x02060200 // B相功率因素
-		PhaseCPowerFactor 	(0xFFFF, "", 0, "X.XXX", 2, "")				= 0x02060300 // C相功率因素
-		PowerFactor       	(0xFFFF, "", 0, "X.XXX", 2, "")				= 0x0206FF00 // 功率因素数据块
-		ABLineVoltage 		(0xB691, "XXX", 2, "XXX.X", 2, "V")			= 0x020C0100 // AB线电压
-		BCLineVoltage 		(0xB692, "XXX", 2, "XXX.X", 2, "V")			= 0x020C0200 // BC线电压
-		CALineVoltage 		(0xB693, "XXX", 2, "XXX.X", 2, "V")			= 0x020C0300 // CA线电压
-		LineVoltage   		(0xFFFF, "", 0, "XXX.X", 2, "V")			= 0x020CFF00 // 线电压数据块
-		Frequency 			(0xFFFF, "", 0, "XX.XX", 2, "Hz")			= 0x02800002 // 频率
+		PhaseAVoltage 		(0xB611, "XXX", 2, "XXX.X", 2, "V", false)			= 0x02010100 // A相电压
+		PhaseBVoltage 		(0xB612, "XXX", 2, "XXX.X", 2, "V", false)			= 0x02010200 // B相电压
+		PhaseCVoltage 		(0xB613, "XXX", 2, "XXX.X", 2, "V", false)			= 0x02010300 // C相电压
+		Voltage       		(0xB61F, "XXX", 2, "XXX.X", 2, "V", false)			= 0x0201FF00 // 电压数据块
+		PhaseACurrent 		(0xB621, "XX.XX", 2, "XXX.XXX", 3, "A", true)		= 0x02020100 // A相电流
+		PhaseBCurrent 		(0xB622, "XX.XX", 2, "XXX.XXX", 3, "A", true)		= 0x02020200 // B相电流
+		PhaseCCurrent 		(0xB623, "XX.XX", 2, "XXX.XXX", 3, "A", true)		= 0x02020300 // C相电流
+		Current       		(0xB62F, "XX.XX", 2, "XXX.XXX", 3, "A", true)			= 0x0202FF00 // 电流数据块
+		TotalActivePower  	(0xB630, "XX.XXXX", 3, "XX.XXXX", 3, "kW", true)	= 0x02030000 // 总有功功率
+		PhaseAActivePower 	(0xB631, "XX.XXXX", 3, "XX.XXXX", 3, "kW", true)	= 0x02030100 // A相有功功率
+		PhaseBActivePower 	(0xB632, "XX.XXXX", 3, "XX.XXXX", 3, "kW", true)	= 0x02030200 // B相有功功率
+		PhaseCActivePower 	(0xB633, "XX.XXXX", 3, "XX.XXXX", 3, "kW", true)	= 0x02030300 // C相有功功率
+		ActivePower       	(0xB63F, "XX.XXXX", 3, "XX.XXXX", 3, "kW", true)			= 0x0203FF00 // 有功功率数据块
+		TotalReactivePower  (0xB640, "XX.XX", 2, "XX.XXXX", 3, "kvar", true)		= 0x02040000 // 总无功功率
+		PhaseAReactivePower (0xB641, "XX.XX", 2, "XX.XXXX", 3, "kvar", true)		= 0x02040100 // A相无功功率
+		PhaseBReactivePower (0xB642, "XX.XX", 2, "XX.XXXX", 3, "kvar", true)		= 0x02040200 // B相无功功率
+		PhaseCReactivePower (0xB643, "XX.XX", 2, "XX.XXXX", 3, "kvar", true)		= 0x02040300 // C相无功功率
+		ReactivePower       (0xB64F, "XX.XX", 2, "XX.XXXX", 3, "kvar", true)		= 0x0204FF00 // 无功功率数据块
+		TotalApparentPower  (0xB660, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x02050000 // 总视在功率
+		PhaseAApparentPower (0xB661, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x02050100 // A相视在功率
+		PhaseBApparentPower (0xB662, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x02050200 // B相视在功率
+		PhaseCApparentPower (0xB663, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x02050300 // C相视在功率
+		ApparentPower       (0xFFFF, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x0205FF00 // 视在功率数据块
+		TotalPowerFactor  	(0xB650, "X.XXX", 2, "X.XXX", 2, "", true)				= 0x02060000 // 总功率因素
+		PhaseAPowerFactor 	(0xB651, "X.XXX", 2, "X.XXX", 2, "", true)				= 0x02060100 // A相功率因素
+		PhaseBPowerFactor 	(0xB652, "X.XXX", 2, "X.XXX", 2, "", true)				= 0x02060200 // B相功率因素
+		PhaseCPowerFactor 	(0xB653, "X.XXX", 2, "X.XXX", 2, "", true)				= 0x02060300 // C相功率因素
+		PowerFactor       	(0xB65F, "X.XXX", 2, "X.XXX", 2, "", true)				= 0x0206FF00 // 功率因素数据块
+		PhaseAAngle 		(0xFFFF, "", 0, "XXX.X", 2, "°", false)		= 0x02070100 // A相相角
+		PhaseBAngle 		(0xFFFF, "", 0, "XXX.X", 2, "°", false)		= 0x02070200 // B相相角
+		PhaseCAngle 		(0xFFFF, "", 0, "XXX.X", 2, "°", false)		= 0x02070300 // C相相角
+		PhaseAngle 			(0xFFFF, "", 0, "XXX.X", 2, "°", false)		= 0x0207FF00 // 相角数据块
+		PhaseAVoltageTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0101 // A相电压总谐波含量
+		PhaseAVoltageHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0102 // A相电压2次谐波含量
+		PhaseAVoltageHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0103 // A相电压3次谐波含量
+		PhaseAVoltageHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0104 // A相电压4次谐波含量
+		PhaseAVoltageHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0105 // A相电压5次谐波含量
+		PhaseAVoltageHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0106 // A相电压6次谐波含量
+		PhaseAVoltageHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0107 // A相电压7次谐波含量
+		PhaseAVoltageHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0108 // A相电压8次谐波含量
+		PhaseAVoltageHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0109 // A相电压9次谐波含量
+		PhaseAVoltageHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010A // A相电压10次谐波含量
+		PhaseAVoltageHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010B // A相电压11次谐波含量
+		PhaseAVoltageHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010C // A相电压12次谐波含量
+		PhaseAVoltageHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010D // A相电压13次谐波含量
+		PhaseAVoltageHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010E // A相电压14次谐波含量
+		PhaseAVoltageHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A010F // A相电压15次谐波含量
+		PhaseAVoltageHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0110 // A相电压16次谐波含量
+		PhaseAVoltageHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0111 // A相电压17次谐波含量
+		PhaseAVoltageHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0112 // A相电压18次谐波含量
+		PhaseAVoltageHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0113 // A相电压19次谐波含量
+		PhaseAVoltageHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0114 // A相电压20次谐波含量
+		PhaseAVoltageHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0115 // A相电压21次谐波含量
+		PhaseAVoltageHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A01FF // A相电压谐波含量数据块
+		PhaseBVoltageTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0201 // B相电压总谐波含量
+		PhaseBVoltageHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0202 // B相电压2次谐波含量
+		PhaseBVoltageHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0203 // B相电压3次谐波含量
+		PhaseBVoltageHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0204 // B相电压4次谐波含量
+		PhaseBVoltageHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0205 // B相电压5次谐波含量
+		PhaseBVoltageHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0206 // B相电压6次谐波含量
+		PhaseBVoltageHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0207 // B相电压7次谐波含量
+		PhaseBVoltageHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0208 // B相电压8次谐波含量
+		PhaseBVoltageHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0209 // B相电压9次谐波含量
+		PhaseBVoltageHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020A // B相电压10次谐波含量
+		PhaseBVoltageHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020B // B相电压11次谐波含量
+		PhaseBVoltageHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020C // B相电压12次谐波含量
+		PhaseBVoltageHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020D // B相电压13次谐波含量
+		PhaseBVoltageHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020E // B相电压14次谐波含量
+		PhaseBVoltageHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A020F // B相电压15次谐波含量
+		PhaseBVoltageHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0210 // B相电压16次谐波含量
+		PhaseBVoltageHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0211 // B相电压17次谐波含量
+		PhaseBVoltageHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0212 // B相电压18次谐波含量
+		PhaseBVoltageHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0213 // B相电压19次谐波含量
+		PhaseBVoltageHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0214 // B相电压20次谐波含量
+		PhaseBVoltageHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0215 // B相电压21次谐波含量
+		PhaseBVoltageHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A02FF // B相电压谐波含量数据块
+		PhaseCVoltageTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0301 // C相电压总谐波含量
+		PhaseCVoltageHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0302 // C相电压2次谐波含量
+		PhaseCVoltageHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0303 // C相电压3次谐波含量
+		PhaseCVoltageHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0304 // C相电压4次谐波含量
+		PhaseCVoltageHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0305 // C相电压5次谐波含量
+		PhaseCVoltageHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0306 // C相电压6次谐波含量
+		PhaseCVoltageHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0307 // C相电压7次谐波含量
+		PhaseCVoltageHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0308 // C相电压8次谐波含量
+		PhaseCVoltageHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0309 // C相电压9次谐波含量
+		PhaseCVoltageHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030A // C相电压10次谐波含量
+		PhaseCVoltageHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030B // C相电压11次谐波含量
+		PhaseCVoltageHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030C // C相电压12次谐波含量
+		PhaseCVoltageHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030D // C相电压13次谐波含量
+		PhaseCVoltageHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030E // C相电压14次谐波含量
+		PhaseCVoltageHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A030F // C相电压15次谐波含量
+		PhaseCVoltageHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0310 // C相电压16次谐波含量
+		PhaseCVoltageHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0311 // C相电压17次谐波含量
+		PhaseCVoltageHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0312 // C相电压18次谐波含量
+		PhaseCVoltageHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0313 // C相电压19次谐波含量
+		PhaseCVoltageHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0314 // C相电压20次谐波含量
+		PhaseCVoltageHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A0315 // C相电压21次谐波含量
+		PhaseCVoltageHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020A03FF // C相电压谐波含量数据块
+		PhaseACurrentTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0101 // A相电流总谐波含量
+		PhaseACurrentHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0102 // A相电流2次谐波含量
+		PhaseACurrentHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0103 // A相电流3次谐波含量
+		PhaseACurrentHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0104 // A相电流4次谐波含量
+		PhaseACurrentHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0105 // A相电流5次谐波含量
+		PhaseACurrentHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0106 // A相电流6次谐波含量
+		PhaseACurrentHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0107 // A相电流7次谐波含量
+		PhaseACurrentHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0108 // A相电流8次谐波含量
+		PhaseACurrentHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0109 // A相电流9次谐波含量
+		PhaseACurrentHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010A // A相电流10次谐波含量
+		PhaseACurrentHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010B // A相电流11次谐波含量
+		PhaseACurrentHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010C // A相电流12次谐波含量
+		PhaseACurrentHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010D // A相电流13次谐波含量
+		PhaseACurrentHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010E // A相电流14次谐波含量
+		PhaseACurrentHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B010F // A相电流15次谐波含量
+		PhaseACurrentHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0110 // A相电流16次谐波含量
+		PhaseACurrentHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0111 // A相电流17次谐波含量
+		PhaseACurrentHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0112 // A相电流18次谐波含量
+		PhaseACurrentHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0113 // A相电流19次谐波含量
+		PhaseACurrentHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0114 // A相电流20次谐波含量
+		PhaseACurrentHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0115 // A相电流21次谐波含量
+		PhaseACurrentHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B01FF // A相电流谐波含量数据块
+		PhaseBCurrentTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0201 // B相电流总谐波含量
+		PhaseBCurrentHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0202 // B相电流2次谐波含量
+		PhaseBCurrentHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0203 // B相电流3次谐波含量
+		PhaseBCurrentHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0204 // B相电流4次谐波含量
+		PhaseBCurrentHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0205 // B相电流5次谐波含量
+		PhaseBCurrentHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0206 // B相电流6次谐波含量
+		PhaseBCurrentHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0207 // B相电流7次谐波含量
+		PhaseBCurrentHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0208 // B相电流8次谐波含量
+		PhaseBCurrentHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0209 // B相电流9次谐波含量
+		PhaseBCurrentHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020A // B相电流10次谐波含量
+		PhaseBCurrentHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020B // B相电流11次谐波含量
+		PhaseBCurrentHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020C // B相电流12次谐波含量
+		PhaseBCurrentHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020D // B相电流13次谐波含量
+		PhaseBCurrentHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020E // B相电流14次谐波含量
+		PhaseBCurrentHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B020F // B相电流15次谐波含量
+		PhaseBCurrentHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0210 // B相电流16次谐波含量
+		PhaseBCurrentHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0211 // B相电流17次谐波含量
+		PhaseBCurrentHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0212 // B相电流18次谐波含量
+		PhaseBCurrentHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0213 // B相电流19次谐波含量
+		PhaseBCurrentHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0214 // B相电流20次谐波含量
+		PhaseBCurrentHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0215 // B相电流21次谐波含量
+		PhaseBCurrentHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B02FF // B相电流谐波含量数据块
+		PhaseCCurrentTHD		(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0301 // C相电流总谐波含量
+		PhaseCCurrentHarmonic2	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0302 // C相电流2次谐波含量
+		PhaseCCurrentHarmonic3	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0303 // C相电流3次谐波含量
+		PhaseCCurrentHarmonic4	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0304 // C相电流4次谐波含量
+		PhaseCCurrentHarmonic5	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0305 // C相电流5次谐波含量
+		PhaseCCurrentHarmonic6	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0306 // C相电流6次谐波含量
+		PhaseCCurrentHarmonic7	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0307 // C相电流7次谐波含量
+		PhaseCCurrentHarmonic8	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0308 // C相电流8次谐波含量
+		PhaseCCurrentHarmonic9	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0309 // C相电流9次谐波含量
+		PhaseCCurrentHarmonic10	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030A // C相电流10次谐波含量
+		PhaseCCurrentHarmonic11	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030B // C相电流11次谐波含量
+		PhaseCCurrentHarmonic12	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030C // C相电流12次谐波含量
+		PhaseCCurrentHarmonic13	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030D // C相电流13次谐波含量
+		PhaseCCurrentHarmonic14	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030E // C相电流14次谐波含量
+		PhaseCCurrentHarmonic15	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B030F // C相电流15次谐波含量
+		PhaseCCurrentHarmonic16	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0310 // C相电流16次谐波含量
+		PhaseCCurrentHarmonic17	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0311 // C相电流17次谐波含量
+		PhaseCCurrentHarmonic18	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0312 // C相电流18次谐波含量
+		PhaseCCurrentHarmonic19	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0313 // C相电流19次谐波含量
+		PhaseCCurrentHarmonic20	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0314 // C相电流20次谐波含量
+		PhaseCCurrentHarmonic21	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B0315 // C相电流21次谐波含量
+		PhaseCCurrentHarmonic	(0xFFFF, "", 0, "XX.XX", 2, "%", false)		= 0x020B03FF // C相电流谐波含量数据块
+		ABLineVoltage 		(0xB691, "XXX", 2, "XXX.X", 2, "V", false)			= 0x020C0100 // AB线电压
+		BCLineVoltage 		(0xB692, "XXX", 2, "XXX.X", 2, "V", false)			= 0x020C0200 // BC线电压
+		CALineVoltage 		(0xB693, "XXX", 2, "XXX.X", 2, "V", false)			= 0x020C0300 // CA线电压
+		LineVoltage   		(0xFFFF, "", 0, "XXX.X", 2, "V", false)			= 0x020CFF00 // 线电压数据块
+		NeutralCurrent 		(0xFFFF, "", 0, "XXX.XXX", 3, "A", true)		= 0x02800001 // 零线电流
+		Frequency 			(0xFFFF, "", 0, "XX.XX", 2, "Hz", false)			= 0x02800002 // 频率
+		AverageActivePower 	(0xFFFF, "", 0, "XX.XXXX", 3, "kW", true)		= 0x02800003 // 一分钟有功总平均功率
+		ActiveDemand 		(0xFFFF, "", 0, "XX.XXXX", 3, "kW", true)		= 0x02800004 // 当前有功需量
+		ReactiveDemand 		(0xFFFF, "", 0, "XX.XXXX", 3, "kvar", true)		= 0x02800005 // 当前无功需量
+		ApparentDemand 		(0xFFFF, "", 0, "XX.XXXX", 3, "kVA", false)		= 0x02800006 // 当前视在需量
+		Temperature 		(0xFFFF, "", 0, "XXX.X", 2, "℃", true)			= 0x02800007 // 表内温度
+		ClockBatteryVoltage (0xFFFF, "", 0, "XX.XX", 2, "V", false)			= 0x02800008 // 时钟电池电压(内部)
+		ReadingBatteryVoltage (0xFFFF, "", 0, "XX.XX", 2, "V", false)		= 0x02800009 // 停电抄表电池电压(外部)
+		BatteryRunTime 		(0xB214, "NNNNNN", 3, "XXXXXXXX", 4, "分", false)		= 0x0280000A // 内部电池工作时间
+		CurrentTariffPrice 	(0xFFFF, "", 0, "XXXX.XXXX", 4, "元/kWh", false)	= 0x0280000B // 当前阶梯电价
 
 		// 事件记录数据标识
-		TotalOverCurrentCount   (0xFFFF, "", 0, "XXXXXX, XXXXXX", 6, "次,分")	= 0x030C0000 // 过流总次数，总时间
-		TotalMeterResetCount 	(0xFFFF, "", 0, "XXXXXX", 3, "次")				= 0x03300100 // 电表清零总次数
-		MeterResetRecord     	(0xFFFF, "", 0, "", 0, "")						= 0x03300101 // 电表清零记录, 这个返回的是一个对象的结构体
+		TotalOverCurrentCount   (0xFFFF, "", 0, "XXXXXX, XXXXXX", 6, "次,分", false)	= 0x030C0000 // 过流总次数，总时间
+		TotalPowerDownCount 			(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03110000 // 掉电总次数
+		PowerDownRecord 				(0xFFFF, "", 0, "", 12, "", false)		= 0x03110001 // 上1次掉电记录
+		TotalProgramCount 				(0xB212, "NNNN", 2, "XXXXXX", 3, "次", false)	= 0x03300000 // 编程总次数
+		ProgramRecord 					(0xFFFF, "", 0, "", 50, "", false)		= 0x03300001 // 上1次编程记录
+		TotalMeterResetCount 			(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03300100 // 电表清零总次数
+		MeterResetRecord     			(0xFFFF, "", 0, "", 106, "", false)		= 0x03300101 // 电表清零记录, 这个返回的是一个对象的结构体
+		TotalDemandResetCount 			(0xB213, "NNNN", 2, "XXXXXX", 3, "次", false)	= 0x03300200 // 需量清零总次数
+		DemandResetRecord 				(0xFFFF, "", 0, "", 202, "", false)		= 0x03300201 // 上1次需量清零记录
+		TotalEventResetCount 			(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03300300 // 事件清零总次数
+		EventResetRecord 				(0xFFFF, "", 0, "", 14, "", false)		= 0x03300301 // 上1次事件清零记录
+		TotalClockAdjustCount 			(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03300400 // 校时总次数
+		ClockAdjustRecord 				(0xFFFF, "", 0, "", 16, "", false)		= 0x03300401 // 上1次校时记录
+		TotalMeterCoverOpenCount 		(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03300D00 // 开表盖总次数
+		MeterCoverOpenRecord 			(0xFFFF, "", 0, "", 60, "", false)		= 0x03300D01 // 上1次开表盖记录
+		TotalTerminalCoverOpenCount 	(0xFFFF, "", 0, "XXXXXX", 3, "次", false)	= 0x03300E00 // 开端钮盒总次数
+		TerminalCoverOpenRecord 		(0xFFFF, "", 0, "", 60, "", false)		= 0x03300E01 // 上1次开端钮盒记录
+		LastPurchaseTime 				(0xFFFF, "", 0, "YYMMDDhhmm", 5, "年月日时分", false)	= 0x03330101 // 上1次购电日期
+		LastPurchaseCount 				(0xFFFF, "", 0, "XXXX", 2, "次", false)		= 0x03330201 // 上1次购电后总购电次数
+		LastPurchaseAmount 				(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x03330301 // 上1次购电金额
+		LastPurchaseBalanceBefore 		(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x03330401 // 上1次购电前剩余金额
+		LastPurchaseBalanceAfter 		(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x03330501 // 上1次购电后剩余金额
+		LastTotalPurchaseAmount 		(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x03330601 // 上1次购电后累计购电金额
 
 		// 参变量数据标识
-		DateTime            (0xFFFF, "", 0, "YYMMDDWW", 4, "年月日星期")  = 0x04000101 // 年月日星期
-		Time                (0xFFFF, "", 0, "hhmmss", 3, "时分秒")		= 0x04000102 // 时分秒
-		AssetManagementCode (0xFFFF, "", 0, "N", 32, "")				= 0x04000403 // 资产管理编码
-		ActiveConstant		(0xFFFF, "", 0, "XXXXXX", 3, "imp/kWh")		= 0x04000409 // 电表有功常数
-		ReactiveConstant	(0xFFFF, "", 0, "XXXXXX", 3, "imp/kvarh")	= 0x0400040A // 电表无功常数
+		DateTime            (0xC010, "YYMMDDWW", 4, "YYMMDDWW", 4, "年月日星期", false)  = 0x04000101 // 年月日星期
+		Time                (0xC011, "hhmmss", 3, "hhmmss", 3, "时分秒", false)		= 0x04000102 // 时分秒
+		DemandPeriod		(0xC111, "NN", 1, "NN", 1, "分", false)			= 0x04000103 // 最大需量周期
+		SlidingTime			(0xC112, "NN", 1, "NN", 1, "分", false)			= 0x04000104 // 滑差时间
+		TimeZoneSwitchTime	(0xFFFF, "", 0, "YYMMDDhhmm", 5, "年月日时分", false)	= 0x04000106 // 两套时区表切换时间
+		DailyTableSwitchTime	(0xFFFF, "", 0, "YYMMDDhhmm", 5, "年月日时分", false)	= 0x04000107 // 两套日时段表切换时间
+		TariffSwitchTime	(0xFFFF, "", 0, "YYMMDDhhmm", 5, "年月日时分", false)	= 0x04000108 // 两套分时费率切换时间
+		StepSwitchTime		(0xFFFF, "", 0, "YYMMDDhhmm", 5, "年月日时分", false)	= 0x04000109 // 两套阶梯切换时间
+		TimeZoneCount		(0xC310, "NN", 1, "NN", 1, "", false)			= 0x04000201 // 年时区数
+		DailyTableCount		(0xC311, "NN", 1, "NN", 1, "", false)			= 0x04000202 // 日时段表数
+		PeriodCount			(0xC312, "NN", 1, "NN", 1, "", false)			= 0x04000203 // 日时段数
+		TariffCount			(0xC313, "NN", 1, "NN", 1, "", false)			= 0x04000204 // 费率数
+		HolidayCount		(0xC314, "NN", 1, "NNNN", 2, "", false)			= 0x04000205 // 公共假日数
+		MeterNumber			(0xC032, "NNNNNNNNNNNN", 6, "NNNNNNNNNNNN", 6, "", false)	= 0x04000402 // 表号
+		AssetManagementCode (0xFFFF, "", 0, "N", 32, "", false)				= 0x04000403 // 资产管理编码
+		ActiveConstant		(0xC030, "NNNNNN", 3, "XXXXXX", 3, "imp/kWh", false)		= 0x04000409 // 电表有功常数
+		ReactiveConstant	(0xC031, "NNNNNN", 3, "XXXXXX", 3, "imp/kvarh", false)	= 0x0400040A // 电表无功常数
+		UserNumber			(0xC033, "NNNNNNNNNNNN", 6, "NNNNNNNNNNNN", 6, "", false)	= 0x0400040E // 用户号
+		RunningStatusWord1	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000501 // 电表运行状态字1
+		RunningStatusWord2	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000502 // 电表运行状态字2
+		RunningStatusWord3	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000503 // 电表运行状态字3
+		RunningStatusWord4	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000504 // 电表运行状态字4, A相故障状态
+		RunningStatusWord5	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000505 // 电表运行状态字5, B相故障状态
+		RunningStatusWord6	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000506 // 电表运行状态字6, C相故障状态
+		RunningStatusWord7	(0xFFFF, "", 0, "", 2, "", false)					= 0x04000507 // 电表运行状态字7, 合相故障状态
+		RunningStatusWord	(0xFFFF, "", 0, "", 2, "", false)					= 0x040005FF // 电表运行状态字数据块
+		AlarmAmount1Limit	(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x04001001 // 报警金额1限值
+		AlarmAmount2Limit	(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x04001002 // 报警金额2限值
+		OverdraftAmountLimit	(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x04001003 // 透支金额限值
+		HoardingAmountLimit	(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x04001004 // 囤积金额限值
+		CloseAllowedAmountLimit	(0xFFFF, "", 0, "XXXXXX.XX", 4, "元", false)	= 0x04001005 // 合闸允许金额限值
+		ActiveReportStatusWord	(0xFFFF, "", 0, "", 12, "", false)				= 0x04001501 // 主动上报状态字
+		FirstTimeZoneTable	(0xFFFF, "", 0, "MMDDNN", 42, "", false)		= 0x04010000 // 第一套时区表数据
+		FirstDailyTable1	(0xFFFF, "", 0, "hhmmNN", 42, "", false)		= 0x04010001 // 第一套第1日时段表数据
+		SecondTimeZoneTable	(0xFFFF, "", 0, "MMDDNN", 42, "", false)		= 0x04020000 // 第二套时区表数据
+		SecondDailyTable1	(0xFFFF, "", 0, "hhmmNN", 42, "", false)		= 0x04020001 // 第二套第1日时段表数据
+		Holiday1			(0xFFFF, "", 0, "YYMMDDNN", 4, "", false)		= 0x04030001 // 第1公共假日日期及日时段表号
+
+		// 1997专用数据标识, 2007中没有对应的数据标识, 其值为 0xFFFF0000 | old
+		PositiveTotalReactiveEnergy     (0x9110, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9110 // 正向无功总电能
+		PositiveReactiveEnergyRate1     (0x9111, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9111 // 正向无功费率1电能
+		PositiveReactiveEnergyRate2     (0x9112, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9112 // 正向无功费率2电能
+		PositiveReactiveEnergyRate3     (0x9113, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9113 // 正向无功费率3电能
+		PositiveReactiveEnergyRate4     (0x9114, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9114 // 正向无功费率4电能
+		PositiveReactiveEnergy          (0x911F, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF911F // 正向无功电能数据块
+		NegativeTotalReactiveEnergy     (0x9120, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9120 // 反向无功总电能
+		NegativeReactiveEnergyRate1     (0x9121, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9121 // 反向无功费率1电能
+		NegativeReactiveEnergyRate2     (0x9122, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9122 // 反向无功费率2电能
+		NegativeReactiveEnergyRate3     (0x9123, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9123 // 反向无功费率3电能
+		NegativeReactiveEnergyRate4     (0x9124, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF9124 // 反向无功费率4电能
+		NegativeReactiveEnergy          (0x912F, "XXXXXX.XX", 4, "", 0, "kvarh", false)	= 0xFFFF912F // 反向无功电能数据块
+		PositiveReactiveMaxDemand       (0xA110, "XX.XXXX", 3, "", 0, "kvar", false)	= 0xFFFFA110 // 正向无功总最大需量
+		NegativeReactiveMaxDemand       (0xA120, "XX.XXXX", 3, "", 0, "kvar", false)	= 0xFFFFA120 // 反向无功总最大需量
+		PositiveActiveMaxDemandTime     (0xB010, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB010 // 正向有功总最大需量发生时间
+		PositiveActiveMaxDemandRate1Time (0xB011, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB011 // 正向有功费率1最大需量发生时间
+		PositiveActiveMaxDemandRate2Time (0xB012, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB012 // 正向有功费率2最大需量发生时间
+		PositiveActiveMaxDemandRate3Time (0xB013, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB013 // 正向有功费率3最大需量发生时间
+		PositiveActiveMaxDemandRate4Time (0xB014, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB014 // 正向有功费率4最大需量发生时间
+		PositiveActiveMaxDemandTimeBlock (0xB01F, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB01F // 正向有功最大需量发生时间数据块
+		NegativeActiveMaxDemandTime     (0xB020, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB020 // 反向有功总最大需量发生时间
+		PositiveReactiveMaxDemandTime   (0xB110, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB110 // 正向无功总最大需量发生时间
+		NegativeReactiveMaxDemandTime   (0xB120, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB120 // 反向无功总最大需量发生时间
+		LastProgramTime                 (0xB210, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB210 // 最近一次编程时间
+		LastDemandResetTime             (0xB211, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB211 // 最近一次最大需量清零时间
+		TotalPhaseBreakCount            (0xB310, "NNNN", 2, "", 0, "次", false)	= 0xFFFFB310 // 总断相次数
+		PhaseABreakCount                (0xB311, "NNNN", 2, "", 0, "次", false)	= 0xFFFFB311 // A相断相次数
+		PhaseBBreakCount                (0xB312, "NNNN", 2, "", 0, "次", false)	= 0xFFFFB312 // B相断相次数
+		PhaseCBreakCount                (0xB313, "NNNN", 2, "", 0, "次", false)	= 0xFFFFB313 // C相断相次数
+		PhaseBreakCount                 (0xB31F, "NNNN", 2, "", 0, "次", false)	= 0xFFFFB31F // 断相次数数据块
+		TotalPhaseBreakTime             (0xB320, "NNNNNN", 3, "", 0, "分", false)	= 0xFFFFB320 // 总断相时间累计值
+		PhaseABreakTime                 (0xB321, "NNNNNN", 3, "", 0, "分", false)	= 0xFFFFB321 // A相断相时间累计值
+		PhaseBBreakTime                 (0xB322, "NNNNNN", 3, "", 0, "分", false)	= 0xFFFFB322 // B相断相时间累计值
+		PhaseCBreakTime                 (0xB323, "NNNNNN", 3, "", 0, "分", false)	= 0xFFFFB323 // C相断相时间累计值
+		PhaseBreakTime                  (0xB32F, "NNNNNN", 3, "", 0, "分", false)	= 0xFFFFB32F // 断相时间累计值数据块
+		TotalPhaseLastBreakStartTime    (0xB330, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB330 // 总最近一次断相起始时刻
+		PhaseALastBreakStartTime        (0xB331, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB331 // A相最近一次断相起始时刻
+		PhaseBLastBreakStartTime        (0xB332, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB332 // B相最近一次断相起始时刻
+		PhaseCLastBreakStartTime        (0xB333, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB333 // C相最近一次断相起始时刻
+		PhaseLastBreakStartTime         (0xB33F, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB33F // 最近一次断相起始时刻数据块
+		TotalPhaseLastBreakEndTime      (0xB340, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB340 // 总最近一次断相结束时刻
+		PhaseALastBreakEndTime          (0xB341, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB341 // A相最近一次断相结束时刻
+		PhaseBLastBreakEndTime          (0xB342, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB342 // B相最近一次断相结束时刻
+		PhaseCLastBreakEndTime          (0xB343, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB343 // C相最近一次断相结束时刻
+		PhaseLastBreakEndTime           (0xB34F, "MMDDhhmm", 4, "", 0, "月日时分", false)	= 0xFFFFB34F // 最近一次断相结束时刻数据块
+		MeterStatusWord                 (0xC020, "", 1, "", 0, "", false)	= 0xFFFFC020 // 电表运行状态字
+		GridStatusWord                  (0xC021, "", 1, "", 0, "", false)	= 0xFFFFC021 // 电网状态字
 	}
 */
 type DIC uint32
 
+// InstantaneousDICs is the 2007 dics of the instantaneous snapshot of the meter, can be used by BatchRead
+var InstantaneousDICs = []DIC{
+	DICVoltage, DICCurrent, DICActivePower, DICReactivePower, DICApparentPower, DICPowerFactor, DICPhaseAngle,
+	DICLineVoltage, DICNeutralCurrent, DICFrequency, DICAverageActivePower, DICActiveDemand, DICReactiveDemand,
+	DICApparentDemand, DICTemperature, DICClockBatteryVoltage, DICReadingBatteryVoltage, DICBatteryRunTime,
+	DICCurrentTariffPrice,
+}
+
+// RawSize is the size of the raw dic, the data of the raw dic is not decoded
+const RawSize = -1
+
+// Supported return true if the dic has the code of the protocol
+func (dic DIC) Supported(protocol P) bool {
+	a := dic.attr()
+	return a.supported(protocol)
+}
+
+// IsRaw return true if the dic is the ad-hoc raw dic of the protocol, which is neither generated nor registered,
+// like the unknown code returned by LookupDIC
+func (dic DIC) IsRaw(protocol P) bool {
+	return dic.Supported(protocol) && dic.Size(protocol) == RawSize
+}
+
+// Code return the code bytes of the dic, nil if the protocol unsupport the dic
 func (dic DIC) Code(protocol P) (ret []byte) {
+	if !dic.Supported(protocol) {
+		return nil
+	}
+
 	if protocol == PV2007 {
 		ret = binary.LittleEndian.AppendUint32(ret, dic.Val())
 	} else {
-		if dic.OldSize() == 0 {
-			panic(fmt.Errorf("1997 unsupport %s code", dic.Name()))
-		}
-		ret = binary.LittleEndian.AppendUint16(ret, dic.Old())
+		ret = binary.LittleEndian.AppendUint16(ret, dic.attr().old)
 	}
 
 	return ret
 }
 
+// Format return the data format of the dic, empty if the protocol unsupport the dic
 func (dic DIC) Format(protocol P) string {
+	a := dic.attr()
+	if !a.supported(protocol) {
+		return ""
+	}
+
 	if protocol == PV2007 {
-		return dic.NewFormat()
-	} else {
-		if dic.OldSize() == 0 {
-			panic(fmt.Errorf("1997 unsupport %s format", dic.Name()))
-		}
-		return dic.OldFormat()
+		return a.newFormat
 	}
+	return a.oldFormat
 }
 
+// Size return the data size of the dic, 0 if the protocol unsupport the dic
 func (dic DIC) Size(protocol P) int {
+	a := dic.attr()
+	if !a.supported(protocol) {
+		return 0
+	}
+
 	if protocol == PV2007 {
-		return dic.NewSize()
-	} else {
-		if dic.OldSize() == 0 {
-			panic(fmt.Errorf("1997 unsupport %s size", dic.Name()))
-		}
-		return dic.OldSize()
+		return a.newSize
 	}
+	return a.oldSize
+}
+
+// valueFormat return the format and size of the value, if the format is composite like "XX.XXXX,YYMMDDhhmm",
+// only the first field is the value
+func (dic DIC) valueFormat(protocol P) (string, int) {
+	format := dic.Format(protocol)
+	if i := strings.Index(format, ","); i != -1 {
+		format = strings.TrimSpace(format[:i])
+		return format, len(strings.ReplaceAll(format, ".", "")) / 2
+	}
+
+	return format, dic.Size(protocol)
 }
 
 func (dic DIC) Scale(protocol P) int {
-	dotIndex := strings.Index(dic.Format(protocol), ".")
+	format, size := dic.valueFormat(protocol)
+	dotIndex := strings.Index(format, ".")
 	if dotIndex == -1 {
 		return 0
 	} else {
-		return dic.Size(protocol)*2 - dotIndex
+		return size*2 - dotIndex
 	}
 }
 
+// decodeBcd decode the bcd data by format, if signed, the highest bit of the highest byte is the sign bit
+func decodeBcd(buf []byte, format string, size int, signed bool) decimal.Decimal {
+	data := buf[:size]
+
+	negative := false
+	if signed && data[size-1]&0x80 != 0 {
+		negative = true
+		data = append([]byte{}, data...)
+		data[size-1] &= 0x7F
+	}
+
+	value := decimal.NewFromUint64(bcdToUint(data, size))
+	if dotIndex := strings.Index(format, "."); dotIndex != -1 {
+		value = value.Shift(-int32(size*2 - dotIndex))
+	}
+
+	if negative {
+		value = value.Neg()
+	}
+
+	return value
+}
+
+// Decode the bcd data of the dic, if the dic is signed, the highest bit of the highest byte is the sign bit.
+// 1997 has no sign bit, the composite format only decode the first field.
+func (dic DIC) Decode(buf []byte, protocol P) decimal.Decimal {
+	format, size := dic.valueFormat(protocol)
+	return decodeBcd(buf, format, size, protocol == PV2007 && dic.attr().signed)
+}
+
 func getDICs(dic DIC, bitSize int) (ret []DIC) {
 	prefix := dic.Val() >> bitSize
-	for _, v := range DICValues() {
-		if v.Val()>>bitSize == prefix && v != dic {
+	for _, v := range allDICs() {
+		if v.Val()>>bitSize == prefix && v != dic && v.Supported(PV2007) && !v.IsRaw(PV2007) {
+			ret = append(ret, v)
+		}
+	}
+
+	return ret
+}
+
+// getOldDICs return the 1997 dics of the block, the sub block is not included
+func getOldDICs(dic DIC, bitSize int) (ret []DIC) {
+	prefix := dic.attr().old >> bitSize
+	for _, v := range allDICs() {
+		if old := v.attr().old; old>>bitSize == prefix && old&0xF != 0xF && v.Supported(PV1997) && !v.IsRaw(PV1997) {
 			ret = append(ret, v)
 		}
 	}
@@ -202,6 +581,10 @@ func getDICs(dic DIC, bitSize int) (ret []DIC) {
 
 // if dic code is block, then return true, else false.
 func (dic DIC) CheckBlock(protocol P) (isBlock bool, ret []DIC) {
+	if !dic.Supported(protocol) || dic.IsRaw(protocol) {
+		return false, append([]DIC{}, dic)
+	}
+
 	if protocol == PV2007 {
 		if (dic.Val() & 0xFF) == 0xFF {
 			return true, getDICs(dic, 8)
@@ -217,6 +600,15 @@ func (dic DIC) CheckBlock(protocol P) (isBlock bool, ret []DIC) {
 
 		return false, append([]DIC{}, dic)
 	} else {
+		old := dic.attr().old
+		if (old & 0xFF) == 0xFF {
+			return true, getOldDICs(dic, 8)
+		}
+
+		if (old & 0xF) == 0xF {
+			return true, getOldDICs(dic, 4)
+		}
+
 		return false, append([]DIC{}, dic)
 	}
 }
@@ -45,12 +45,52 @@ const (
 	DICTotalActiveEnergy DIC = 0 // 组合有功总电能
 	// DICPositiveTotalActiveEnergy is a DIC of type PositiveTotalActiveEnergy.
 	DICPositiveTotalActiveEnergy DIC = 65536 // 正向有功总电能
+	// DICPositiveActiveEnergyRate1 is a DIC of type PositiveActiveEnergyRate1.
+	DICPositiveActiveEnergyRate1 DIC = 65792 // 正向有功费率1电能
+	// DICPositiveActiveEnergyRate2 is a DIC of type PositiveActiveEnergyRate2.
+	DICPositiveActiveEnergyRate2 DIC = 66048 // 正向有功费率2电能
+	// DICPositiveActiveEnergyRate3 is a DIC of type PositiveActiveEnergyRate3.
+	DICPositiveActiveEnergyRate3 DIC = 66304 // 正向有功费率3电能
+	// DICPositiveActiveEnergyRate4 is a DIC of type PositiveActiveEnergyRate4.
+	DICPositiveActiveEnergyRate4 DIC = 66560 // 正向有功费率4电能
+	// DICPositiveActiveEnergy is a DIC of type PositiveActiveEnergy.
+	DICPositiveActiveEnergy DIC = 130816 // 正向有功电能数据块
 	// DICNegativeTotalActiveEnergy is a DIC of type NegativeTotalActiveEnergy.
 	DICNegativeTotalActiveEnergy DIC = 131072 // 反向有功总电能
+	// DICNegativeActiveEnergyRate1 is a DIC of type NegativeActiveEnergyRate1.
+	DICNegativeActiveEnergyRate1 DIC = 131328 // 反向有功费率1电能
+	// DICNegativeActiveEnergyRate2 is a DIC of type NegativeActiveEnergyRate2.
+	DICNegativeActiveEnergyRate2 DIC = 131584 // 反向有功费率2电能
+	// DICNegativeActiveEnergyRate3 is a DIC of type NegativeActiveEnergyRate3.
+	DICNegativeActiveEnergyRate3 DIC = 131840 // 反向有功费率3电能
+	// DICNegativeActiveEnergyRate4 is a DIC of type NegativeActiveEnergyRate4.
+	DICNegativeActiveEnergyRate4 DIC = 132096 // 反向有功费率4电能
+	// DICNegativeActiveEnergy is a DIC of type NegativeActiveEnergy.
+	DICNegativeActiveEnergy DIC = 196352 // 反向有功电能数据块
 	// DICTotalReactiveEnergy1 is a DIC of type TotalReactiveEnergy1.
 	DICTotalReactiveEnergy1 DIC = 196608 // 组合无功1总电能
+	// DICReactiveEnergy1Rate1 is a DIC of type ReactiveEnergy1Rate1.
+	DICReactiveEnergy1Rate1 DIC = 196864 // 组合无功1费率1电能
+	// DICReactiveEnergy1Rate2 is a DIC of type ReactiveEnergy1Rate2.
+	DICReactiveEnergy1Rate2 DIC = 197120 // 组合无功1费率2电能
+	// DICReactiveEnergy1Rate3 is a DIC of type ReactiveEnergy1Rate3.
+	DICReactiveEnergy1Rate3 DIC = 197376 // 组合无功1费率3电能
+	// DICReactiveEnergy1Rate4 is a DIC of type ReactiveEnergy1Rate4.
+	DICReactiveEnergy1Rate4 DIC = 197632 // 组合无功1费率4电能
+	// DICReactiveEnergy1 is a DIC of type ReactiveEnergy1.
+	DICReactiveEnergy1 DIC = 261888 // 组合无功1电能数据块
 	// DICTotalReactiveEnergy2 is a DIC of type TotalReactiveEnergy2.
 	DICTotalReactiveEnergy2 DIC = 262144 // 组合无功2总电能
+	// DICReactiveEnergy2Rate1 is a DIC of type ReactiveEnergy2Rate1.
+	DICReactiveEnergy2Rate1 DIC = 262400 // 组合无功2费率1电能
+	// DICReactiveEnergy2Rate2 is a DIC of type ReactiveEnergy2Rate2.
+	DICReactiveEnergy2Rate2 DIC = 262656 // 组合无功2费率2电能
+	// DICReactiveEnergy2Rate3 is a DIC of type ReactiveEnergy2Rate3.
+	DICReactiveEnergy2Rate3 DIC = 262912 // 组合无功2费率3电能
+	// DICReactiveEnergy2Rate4 is a DIC of type ReactiveEnergy2Rate4.
+	DICReactiveEnergy2Rate4 DIC = 263168 // 组合无功2费率4电能
+	// DICReactiveEnergy2 is a DIC of type ReactiveEnergy2.
+	DICReactiveEnergy2 DIC = 327424 // 组合无功2电能数据块
 	// DICFirstQuadrantReactiveEnergy is a DIC of type FirstQuadrantReactiveEnergy.
 	DICFirstQuadrantReactiveEnergy DIC = 327680 // 第一象限无功电能
 	// DICSecondQuadrantReactiveEnergy is a DIC of type SecondQuadrantReactiveEnergy.
@@ -65,6 +105,33 @@ const (
 	DICNegativeTotalApparentEnergy DIC = 655360 // 反向视在总电能
 	// DICAssociatedTotalElectricEnergy is a DIC of type AssociatedTotalElectricEnergy.
 	DICAssociatedTotalElectricEnergy DIC = 8388608 // 关联总电能
+	// DICRemainingEnergy is a DIC of type RemainingEnergy.
+	DICRemainingEnergy DIC = 9437440 // 当前剩余电量
+	// DICOverdraftEnergy is a DIC of type OverdraftEnergy.
+	DICOverdraftEnergy DIC = 9437441 // 当前透支电量
+	// DICRemainingAmount is a DIC of type RemainingAmount.
+	DICRemainingAmount DIC = 9437696 // 当前剩余金额
+	// DICOverdraftAmount is a DIC of type OverdraftAmount.
+	DICOverdraftAmount DIC = 9437697 // 当前透支金额
+	// DICPositiveActiveMaxDemand is a DIC of type PositiveActiveMaxDemand.
+	// 需量数据标识
+	DICPositiveActiveMaxDemand DIC = 16842752 // 正向有功总最大需量及发生时间
+	// DICPositiveActiveMaxDemandRate1 is a DIC of type PositiveActiveMaxDemandRate1.
+	DICPositiveActiveMaxDemandRate1 DIC = 16843008 // 正向有功费率1最大需量及发生时间
+	// DICPositiveActiveMaxDemandRate2 is a DIC of type PositiveActiveMaxDemandRate2.
+	DICPositiveActiveMaxDemandRate2 DIC = 16843264 // 正向有功费率2最大需量及发生时间
+	// DICPositiveActiveMaxDemandRate3 is a DIC of type PositiveActiveMaxDemandRate3.
+	DICPositiveActiveMaxDemandRate3 DIC = 16843520 // 正向有功费率3最大需量及发生时间
+	// DICPositiveActiveMaxDemandRate4 is a DIC of type PositiveActiveMaxDemandRate4.
+	DICPositiveActiveMaxDemandRate4 DIC = 16843776 // 正向有功费率4最大需量及发生时间
+	// DICPositiveActiveMaxDemandBlock is a DIC of type PositiveActiveMaxDemandBlock.
+	DICPositiveActiveMaxDemandBlock DIC = 16908032 // 正向有功最大需量数据块
+	// DICNegativeActiveMaxDemand is a DIC of type NegativeActiveMaxDemand.
+	DICNegativeActiveMaxDemand DIC = 16908288 // 反向有功总最大需量及发生时间
+	// DICReactiveMaxDemand1 is a DIC of type ReactiveMaxDemand1.
+	DICReactiveMaxDemand1 DIC = 16973824 // 组合无功1总最大需量及发生时间
+	// DICReactiveMaxDemand2 is a DIC of type ReactiveMaxDemand2.
+	DICReactiveMaxDemand2 DIC = 17039360 // 组合无功2总最大需量及发生时间
 	// DICPhaseAVoltage is a DIC of type PhaseAVoltage.
 	// 变量数据标识
 	DICPhaseAVoltage DIC = 33620224 // A相电压
@@ -122,6 +189,278 @@ const (
 	DICPhaseCPowerFactor DIC = 33948416 // C相功率因素
 	// DICPowerFactor is a DIC of type PowerFactor.
 	DICPowerFactor DIC = 34012928 // 功率因素数据块
+	// DICPhaseAAngle is a DIC of type PhaseAAngle.
+	DICPhaseAAngle DIC = 34013440 // A相相角
+	// DICPhaseBAngle is a DIC of type PhaseBAngle.
+	DICPhaseBAngle DIC = 34013696 // B相相角
+	// DICPhaseCAngle is a DIC of type PhaseCAngle.
+	DICPhaseCAngle DIC = 34013952 // C相相角
+	// DICPhaseAngle is a DIC of type PhaseAngle.
+	DICPhaseAngle DIC = 34078464 // 相角数据块
+	// DICPhaseAVoltageTHD is a DIC of type PhaseAVoltageTHD.
+	DICPhaseAVoltageTHD DIC = 34210049 // A相电压总谐波含量
+	// DICPhaseAVoltageHarmonic2 is a DIC of type PhaseAVoltageHarmonic2.
+	DICPhaseAVoltageHarmonic2 DIC = 34210050 // A相电压2次谐波含量
+	// DICPhaseAVoltageHarmonic3 is a DIC of type PhaseAVoltageHarmonic3.
+	DICPhaseAVoltageHarmonic3 DIC = 34210051 // A相电压3次谐波含量
+	// DICPhaseAVoltageHarmonic4 is a DIC of type PhaseAVoltageHarmonic4.
+	DICPhaseAVoltageHarmonic4 DIC = 34210052 // A相电压4次谐波含量
+	// DICPhaseAVoltageHarmonic5 is a DIC of type PhaseAVoltageHarmonic5.
+	DICPhaseAVoltageHarmonic5 DIC = 34210053 // A相电压5次谐波含量
+	// DICPhaseAVoltageHarmonic6 is a DIC of type PhaseAVoltageHarmonic6.
+	DICPhaseAVoltageHarmonic6 DIC = 34210054 // A相电压6次谐波含量
+	// DICPhaseAVoltageHarmonic7 is a DIC of type PhaseAVoltageHarmonic7.
+	DICPhaseAVoltageHarmonic7 DIC = 34210055 // A相电压7次谐波含量
+	// DICPhaseAVoltageHarmonic8 is a DIC of type PhaseAVoltageHarmonic8.
+	DICPhaseAVoltageHarmonic8 DIC = 34210056 // A相电压8次谐波含量
+	// DICPhaseAVoltageHarmonic9 is a DIC of type PhaseAVoltageHarmonic9.
+	DICPhaseAVoltageHarmonic9 DIC = 34210057 // A相电压9次谐波含量
+	// DICPhaseAVoltageHarmonic10 is a DIC of type PhaseAVoltageHarmonic10.
+	DICPhaseAVoltageHarmonic10 DIC = 34210058 // A相电压10次谐波含量
+	// DICPhaseAVoltageHarmonic11 is a DIC of type PhaseAVoltageHarmonic11.
+	DICPhaseAVoltageHarmonic11 DIC = 34210059 // A相电压11次谐波含量
+	// DICPhaseAVoltageHarmonic12 is a DIC of type PhaseAVoltageHarmonic12.
+	DICPhaseAVoltageHarmonic12 DIC = 34210060 // A相电压12次谐波含量
+	// DICPhaseAVoltageHarmonic13 is a DIC of type PhaseAVoltageHarmonic13.
+	DICPhaseAVoltageHarmonic13 DIC = 34210061 // A相电压13次谐波含量
+	// DICPhaseAVoltageHarmonic14 is a DIC of type PhaseAVoltageHarmonic14.
+	DICPhaseAVoltageHarmonic14 DIC = 34210062 // A相电压14次谐波含量
+	// DICPhaseAVoltageHarmonic15 is a DIC of type PhaseAVoltageHarmonic15.
+	DICPhaseAVoltageHarmonic15 DIC = 34210063 // A相电压15次谐波含量
+	// DICPhaseAVoltageHarmonic16 is a DIC of type PhaseAVoltageHarmonic16.
+	DICPhaseAVoltageHarmonic16 DIC = 34210064 // A相电压16次谐波含量
+	// DICPhaseAVoltageHarmonic17 is a DIC of type PhaseAVoltageHarmonic17.
+	DICPhaseAVoltageHarmonic17 DIC = 34210065 // A相电压17次谐波含量
+	// DICPhaseAVoltageHarmonic18 is a DIC of type PhaseAVoltageHarmonic18.
+	DICPhaseAVoltageHarmonic18 DIC = 34210066 // A相电压18次谐波含量
+	// DICPhaseAVoltageHarmonic19 is a DIC of type PhaseAVoltageHarmonic19.
+	DICPhaseAVoltageHarmonic19 DIC = 34210067 // A相电压19次谐波含量
+	// DICPhaseAVoltageHarmonic20 is a DIC of type PhaseAVoltageHarmonic20.
+	DICPhaseAVoltageHarmonic20 DIC = 34210068 // A相电压20次谐波含量
+	// DICPhaseAVoltageHarmonic21 is a DIC of type PhaseAVoltageHarmonic21.
+	DICPhaseAVoltageHarmonic21 DIC = 34210069 // A相电压21次谐波含量
+	// DICPhaseAVoltageHarmonic is a DIC of type PhaseAVoltageHarmonic.
+	DICPhaseAVoltageHarmonic DIC = 34210303 // A相电压谐波含量数据块
+	// DICPhaseBVoltageTHD is a DIC of type PhaseBVoltageTHD.
+	DICPhaseBVoltageTHD DIC = 34210305 // B相电压总谐波含量
+	// DICPhaseBVoltageHarmonic2 is a DIC of type PhaseBVoltageHarmonic2.
+	DICPhaseBVoltageHarmonic2 DIC = 34210306 // B相电压2次谐波含量
+	// DICPhaseBVoltageHarmonic3 is a DIC of type PhaseBVoltageHarmonic3.
+	DICPhaseBVoltageHarmonic3 DIC = 34210307 // B相电压3次谐波含量
+	// DICPhaseBVoltageHarmonic4 is a DIC of type PhaseBVoltageHarmonic4.
+	DICPhaseBVoltageHarmonic4 DIC = 34210308 // B相电压4次谐波含量
+	// DICPhaseBVoltageHarmonic5 is a DIC of type PhaseBVoltageHarmonic5.
+	DICPhaseBVoltageHarmonic5 DIC = 34210309 // B相电压5次谐波含量
+	// DICPhaseBVoltageHarmonic6 is a DIC of type PhaseBVoltageHarmonic6.
+	DICPhaseBVoltageHarmonic6 DIC = 34210310 // B相电压6次谐波含量
+	// DICPhaseBVoltageHarmonic7 is a DIC of type PhaseBVoltageHarmonic7.
+	DICPhaseBVoltageHarmonic7 DIC = 34210311 // B相电压7次谐波含量
+	// DICPhaseBVoltageHarmonic8 is a DIC of type PhaseBVoltageHarmonic8.
+	DICPhaseBVoltageHarmonic8 DIC = 34210312 // B相电压8次谐波含量
+	// DICPhaseBVoltageHarmonic9 is a DIC of type PhaseBVoltageHarmonic9.
+	DICPhaseBVoltageHarmonic9 DIC = 34210313 // B相电压9次谐波含量
+	// DICPhaseBVoltageHarmonic10 is a DIC of type PhaseBVoltageHarmonic10.
+	DICPhaseBVoltageHarmonic10 DIC = 34210314 // B相电压10次谐波含量
+	// DICPhaseBVoltageHarmonic11 is a DIC of type PhaseBVoltageHarmonic11.
+	DICPhaseBVoltageHarmonic11 DIC = 34210315 // B相电压11次谐波含量
+	// DICPhaseBVoltageHarmonic12 is a DIC of type PhaseBVoltageHarmonic12.
+	DICPhaseBVoltageHarmonic12 DIC = 34210316 // B相电压12次谐波含量
+	// DICPhaseBVoltageHarmonic13 is a DIC of type PhaseBVoltageHarmonic13.
+	DICPhaseBVoltageHarmonic13 DIC = 34210317 // B相电压13次谐波含量
+	// DICPhaseBVoltageHarmonic14 is a DIC of type PhaseBVoltageHarmonic14.
+	DICPhaseBVoltageHarmonic14 DIC = 34210318 // B相电压14次谐波含量
+	// DICPhaseBVoltageHarmonic15 is a DIC of type PhaseBVoltageHarmonic15.
+	DICPhaseBVoltageHarmonic15 DIC = 34210319 // B相电压15次谐波含量
+	// DICPhaseBVoltageHarmonic16 is a DIC of type PhaseBVoltageHarmonic16.
+	DICPhaseBVoltageHarmonic16 DIC = 34210320 // B相电压16次谐波含量
+	// DICPhaseBVoltageHarmonic17 is a DIC of type PhaseBVoltageHarmonic17.
+	DICPhaseBVoltageHarmonic17 DIC = 34210321 // B相电压17次谐波含量
+	// DICPhaseBVoltageHarmonic18 is a DIC of type PhaseBVoltageHarmonic18.
+	DICPhaseBVoltageHarmonic18 DIC = 34210322 // B相电压18次谐波含量
+	// DICPhaseBVoltageHarmonic19 is a DIC of type PhaseBVoltageHarmonic19.
+	DICPhaseBVoltageHarmonic19 DIC = 34210323 // B相电压19次谐波含量
+	// DICPhaseBVoltageHarmonic20 is a DIC of type PhaseBVoltageHarmonic20.
+	DICPhaseBVoltageHarmonic20 DIC = 34210324 // B相电压20次谐波含量
+	// DICPhaseBVoltageHarmonic21 is a DIC of type PhaseBVoltageHarmonic21.
+	DICPhaseBVoltageHarmonic21 DIC = 34210325 // B相电压21次谐波含量
+	// DICPhaseBVoltageHarmonic is a DIC of type PhaseBVoltageHarmonic.
+	DICPhaseBVoltageHarmonic DIC = 34210559 // B相电压谐波含量数据块
+	// DICPhaseCVoltageTHD is a DIC of type PhaseCVoltageTHD.
+	DICPhaseCVoltageTHD DIC = 34210561 // C相电压总谐波含量
+	// DICPhaseCVoltageHarmonic2 is a DIC of type PhaseCVoltageHarmonic2.
+	DICPhaseCVoltageHarmonic2 DIC = 34210562 // C相电压2次谐波含量
+	// DICPhaseCVoltageHarmonic3 is a DIC of type PhaseCVoltageHarmonic3.
+	DICPhaseCVoltageHarmonic3 DIC = 34210563 // C相电压3次谐波含量
+	// DICPhaseCVoltageHarmonic4 is a DIC of type PhaseCVoltageHarmonic4.
+	DICPhaseCVoltageHarmonic4 DIC = 34210564 // C相电压4次谐波含量
+	// DICPhaseCVoltageHarmonic5 is a DIC of type PhaseCVoltageHarmonic5.
+	DICPhaseCVoltageHarmonic5 DIC = 34210565 // C相电压5次谐波含量
+	// DICPhaseCVoltageHarmonic6 is a DIC of type PhaseCVoltageHarmonic6.
+	DICPhaseCVoltageHarmonic6 DIC = 34210566 // C相电压6次谐波含量
+	// DICPhaseCVoltageHarmonic7 is a DIC of type PhaseCVoltageHarmonic7.
+	DICPhaseCVoltageHarmonic7 DIC = 34210567 // C相电压7次谐波含量
+	// DICPhaseCVoltageHarmonic8 is a DIC of type PhaseCVoltageHarmonic8.
+	DICPhaseCVoltageHarmonic8 DIC = 34210568 // C相电压8次谐波含量
+	// DICPhaseCVoltageHarmonic9 is a DIC of type PhaseCVoltageHarmonic9.
+	DICPhaseCVoltageHarmonic9 DIC = 34210569 // C相电压9次谐波含量
+	// DICPhaseCVoltageHarmonic10 is a DIC of type PhaseCVoltageHarmonic10.
+	DICPhaseCVoltageHarmonic10 DIC = 34210570 // C相电压10次谐波含量
+	// DICPhaseCVoltageHarmonic11 is a DIC of type PhaseCVoltageHarmonic11.
+	DICPhaseCVoltageHarmonic11 DIC = 34210571 // C相电压11次谐波含量
+	// DICPhaseCVoltageHarmonic12 is a DIC of type PhaseCVoltageHarmonic12.
+	DICPhaseCVoltageHarmonic12 DIC = 34210572 // C相电压12次谐波含量
+	// DICPhaseCVoltageHarmonic13 is a DIC of type PhaseCVoltageHarmonic13.
+	DICPhaseCVoltageHarmonic13 DIC = 34210573 // C相电压13次谐波含量
+	// DICPhaseCVoltageHarmonic14 is a DIC of type PhaseCVoltageHarmonic14.
+	DICPhaseCVoltageHarmonic14 DIC = 34210574 // C相电压14次谐波含量
+	// DICPhaseCVoltageHarmonic15 is a DIC of type PhaseCVoltageHarmonic15.
+	DICPhaseCVoltageHarmonic15 DIC = 34210575 // C相电压15次谐波含量
+	// DICPhaseCVoltageHarmonic16 is a DIC of type PhaseCVoltageHarmonic16.
+	DICPhaseCVoltageHarmonic16 DIC = 34210576 // C相电压16次谐波含量
+	// DICPhaseCVoltageHarmonic17 is a DIC of type PhaseCVoltageHarmonic17.
+	DICPhaseCVoltageHarmonic17 DIC = 34210577 // C相电压17次谐波含量
+	// DICPhaseCVoltageHarmonic18 is a DIC of type PhaseCVoltageHarmonic18.
+	DICPhaseCVoltageHarmonic18 DIC = 34210578 // C相电压18次谐波含量
+	// DICPhaseCVoltageHarmonic19 is a DIC of type PhaseCVoltageHarmonic19.
+	DICPhaseCVoltageHarmonic19 DIC = 34210579 // C相电压19次谐波含量
+	// DICPhaseCVoltageHarmonic20 is a DIC of type PhaseCVoltageHarmonic20.
+	DICPhaseCVoltageHarmonic20 DIC = 34210580 // C相电压20次谐波含量
+	// DICPhaseCVoltageHarmonic21 is a DIC of type PhaseCVoltageHarmonic21.
+	DICPhaseCVoltageHarmonic21 DIC = 34210581 // C相电压21次谐波含量
+	// DICPhaseCVoltageHarmonic is a DIC of type PhaseCVoltageHarmonic.
+	DICPhaseCVoltageHarmonic DIC = 34210815 // C相电压谐波含量数据块
+	// DICPhaseACurrentTHD is a DIC of type PhaseACurrentTHD.
+	DICPhaseACurrentTHD DIC = 34275585 // A相电流总谐波含量
+	// DICPhaseACurrentHarmonic2 is a DIC of type PhaseACurrentHarmonic2.
+	DICPhaseACurrentHarmonic2 DIC = 34275586 // A相电流2次谐波含量
+	// DICPhaseACurrentHarmonic3 is a DIC of type PhaseACurrentHarmonic3.
+	DICPhaseACurrentHarmonic3 DIC = 34275587 // A相电流3次谐波含量
+	// DICPhaseACurrentHarmonic4 is a DIC of type PhaseACurrentHarmonic4.
+	DICPhaseACurrentHarmonic4 DIC = 34275588 // A相电流4次谐波含量
+	// DICPhaseACurrentHarmonic5 is a DIC of type PhaseACurrentHarmonic5.
+	DICPhaseACurrentHarmonic5 DIC = 34275589 // A相电流5次谐波含量
+	// DICPhaseACurrentHarmonic6 is a DIC of type PhaseACurrentHarmonic6.
+	DICPhaseACurrentHarmonic6 DIC = 34275590 // A相电流6次谐波含量
+	// DICPhaseACurrentHarmonic7 is a DIC of type PhaseACurrentHarmonic7.
+	DICPhaseACurrentHarmonic7 DIC = 34275591 // A相电流7次谐波含量
+	// DICPhaseACurrentHarmonic8 is a DIC of type PhaseACurrentHarmonic8.
+	DICPhaseACurrentHarmonic8 DIC = 34275592 // A相电流8次谐波含量
+	// DICPhaseACurrentHarmonic9 is a DIC of type PhaseACurrentHarmonic9.
+	DICPhaseACurrentHarmonic9 DIC = 34275593 // A相电流9次谐波含量
+	// DICPhaseACurrentHarmonic10 is a DIC of type PhaseACurrentHarmonic10.
+	DICPhaseACurrentHarmonic10 DIC = 34275594 // A相电流10次谐波含量
+	// DICPhaseACurrentHarmonic11 is a DIC of type PhaseACurrentHarmonic11.
+	DICPhaseACurrentHarmonic11 DIC = 34275595 // A相电流11次谐波含量
+	// DICPhaseACurrentHarmonic12 is a DIC of type PhaseACurrentHarmonic12.
+	DICPhaseACurrentHarmonic12 DIC = 34275596 // A相电流12次谐波含量
+	// DICPhaseACurrentHarmonic13 is a DIC of type PhaseACurrentHarmonic13.
+	DICPhaseACurrentHarmonic13 DIC = 34275597 // A相电流13次谐波含量
+	// DICPhaseACurrentHarmonic14 is a DIC of type PhaseACurrentHarmonic14.
+	DICPhaseACurrentHarmonic14 DIC = 34275598 // A相电流14次谐波含量
+	// DICPhaseACurrentHarmonic15 is a DIC of type PhaseACurrentHarmonic15.
+	DICPhaseACurrentHarmonic15 DIC = 34275599 // A相电流15次谐波含量
+	// DICPhaseACurrentHarmonic16 is a DIC of type PhaseACurrentHarmonic16.
+	DICPhaseACurrentHarmonic16 DIC = 34275600 // A相电流16次谐波含量
+	// DICPhaseACurrentHarmonic17 is a DIC of type PhaseACurrentHarmonic17.
+	DICPhaseACurrentHarmonic17 DIC = 34275601 // A相电流17次谐波含量
+	// DICPhaseACurrentHarmonic18 is a DIC of type PhaseACurrentHarmonic18.
+	DICPhaseACurrentHarmonic18 DIC = 34275602 // A相电流18次谐波含量
+	// DICPhaseACurrentHarmonic19 is a DIC of type PhaseACurrentHarmonic19.
+	DICPhaseACurrentHarmonic19 DIC = 34275603 // A相电流19次谐波含量
+	// DICPhaseACurrentHarmonic20 is a DIC of type PhaseACurrentHarmonic20.
+	DICPhaseACurrentHarmonic20 DIC = 34275604 // A相电流20次谐波含量
+	// DICPhaseACurrentHarmonic21 is a DIC of type PhaseACurrentHarmonic21.
+	DICPhaseACurrentHarmonic21 DIC = 34275605 // A相电流21次谐波含量
+	// DICPhaseACurrentHarmonic is a DIC of type PhaseACurrentHarmonic.
+	DICPhaseACurrentHarmonic DIC = 34275839 // A相电流谐波含量数据块
+	// DICPhaseBCurrentTHD is a DIC of type PhaseBCurrentTHD.
+	DICPhaseBCurrentTHD DIC = 34275841 // B相电流总谐波含量
+	// DICPhaseBCurrentHarmonic2 is a DIC of type PhaseBCurrentHarmonic2.
+	DICPhaseBCurrentHarmonic2 DIC = 34275842 // B相电流2次谐波含量
+	// DICPhaseBCurrentHarmonic3 is a DIC of type PhaseBCurrentHarmonic3.
+	DICPhaseBCurrentHarmonic3 DIC = 34275843 // B相电流3次谐波含量
+	// DICPhaseBCurrentHarmonic4 is a DIC of type PhaseBCurrentHarmonic4.
+	DICPhaseBCurrentHarmonic4 DIC = 34275844 // B相电流4次谐波含量
+	// DICPhaseBCurrentHarmonic5 is a DIC of type PhaseBCurrentHarmonic5.
+	DICPhaseBCurrentHarmonic5 DIC = 34275845 // B相电流5次谐波含量
+	// DICPhaseBCurrentHarmonic6 is a DIC of type PhaseBCurrentHarmonic6.
+	DICPhaseBCurrentHarmonic6 DIC = 34275846 // B相电流6次谐波含量
+	// DICPhaseBCurrentHarmonic7 is a DIC of type PhaseBCurrentHarmonic7.
+	DICPhaseBCurrentHarmonic7 DIC = 34275847 // B相电流7次谐波含量
+	// DICPhaseBCurrentHarmonic8 is a DIC of type PhaseBCurrentHarmonic8.
+	DICPhaseBCurrentHarmonic8 DIC = 34275848 // B相电流8次谐波含量
+	// DICPhaseBCurrentHarmonic9 is a DIC of type PhaseBCurrentHarmonic9.
+	DICPhaseBCurrentHarmonic9 DIC = 34275849 // B相电流9次谐波含量
+	// DICPhaseBCurrentHarmonic10 is a DIC of type PhaseBCurrentHarmonic10.
+	DICPhaseBCurrentHarmonic10 DIC = 34275850 // B相电流10次谐波含量
+	// DICPhaseBCurrentHarmonic11 is a DIC of type PhaseBCurrentHarmonic11.
+	DICPhaseBCurrentHarmonic11 DIC = 34275851 // B相电流11次谐波含量
+	// DICPhaseBCurrentHarmonic12 is a DIC of type PhaseBCurrentHarmonic12.
+	DICPhaseBCurrentHarmonic12 DIC = 34275852 // B相电流12次谐波含量
+	// DICPhaseBCurrentHarmonic13 is a DIC of type PhaseBCurrentHarmonic13.
+	DICPhaseBCurrentHarmonic13 DIC = 34275853 // B相电流13次谐波含量
+	// DICPhaseBCurrentHarmonic14 is a DIC of type PhaseBCurrentHarmonic14.
+	DICPhaseBCurrentHarmonic14 DIC = 34275854 // B相电流14次谐波含量
+	// DICPhaseBCurrentHarmonic15 is a DIC of type PhaseBCurrentHarmonic15.
+	DICPhaseBCurrentHarmonic15 DIC = 34275855 // B相电流15次谐波含量
+	// DICPhaseBCurrentHarmonic16 is a DIC of type PhaseBCurrentHarmonic16.
+	DICPhaseBCurrentHarmonic16 DIC = 34275856 // B相电流16次谐波含量
+	// DICPhaseBCurrentHarmonic17 is a DIC of type PhaseBCurrentHarmonic17.
+	DICPhaseBCurrentHarmonic17 DIC = 34275857 // B相电流17次谐波含量
+	// DICPhaseBCurrentHarmonic18 is a DIC of type PhaseBCurrentHarmonic18.
+	DICPhaseBCurrentHarmonic18 DIC = 34275858 // B相电流18次谐波含量
+	// DICPhaseBCurrentHarmonic19 is a DIC of type PhaseBCurrentHarmonic19.
+	DICPhaseBCurrentHarmonic19 DIC = 34275859 // B相电流19次谐波含量
+	// DICPhaseBCurrentHarmonic20 is a DIC of type PhaseBCurrentHarmonic20.
+	DICPhaseBCurrentHarmonic20 DIC = 34275860 // B相电流20次谐波含量
+	// DICPhaseBCurrentHarmonic21 is a DIC of type PhaseBCurrentHarmonic21.
+	DICPhaseBCurrentHarmonic21 DIC = 34275861 // B相电流21次谐波含量
+	// DICPhaseBCurrentHarmonic is a DIC of type PhaseBCurrentHarmonic.
+	DICPhaseBCurrentHarmonic DIC = 34276095 // B相电流谐波含量数据块
+	// DICPhaseCCurrentTHD is a DIC of type PhaseCCurrentTHD.
+	DICPhaseCCurrentTHD DIC = 34276097 // C相电流总谐波含量
+	// DICPhaseCCurrentHarmonic2 is a DIC of type PhaseCCurrentHarmonic2.
+	DICPhaseCCurrentHarmonic2 DIC = 34276098 // C相电流2次谐波含量
+	// DICPhaseCCurrentHarmonic3 is a DIC of type PhaseCCurrentHarmonic3.
+	DICPhaseCCurrentHarmonic3 DIC = 34276099 // C相电流3次谐波含量
+	// DICPhaseCCurrentHarmonic4 is a DIC of type PhaseCCurrentHarmonic4.
+	DICPhaseCCurrentHarmonic4 DIC = 34276100 // C相电流4次谐波含量
+	// DICPhaseCCurrentHarmonic5 is a DIC of type PhaseCCurrentHarmonic5.
+	DICPhaseCCurrentHarmonic5 DIC = 34276101 // C相电流5次谐波含量
+	// DICPhaseCCurrentHarmonic6 is a DIC of type PhaseCCurrentHarmonic6.
+	DICPhaseCCurrentHarmonic6 DIC = 34276102 // C相电流6次谐波含量
+	// DICPhaseCCurrentHarmonic7 is a DIC of type PhaseCCurrentHarmonic7.
+	DICPhaseCCurrentHarmonic7 DIC = 34276103 // C相电流7次谐波含量
+	// DICPhaseCCurrentHarmonic8 is a DIC of type PhaseCCurrentHarmonic8.
+	DICPhaseCCurrentHarmonic8 DIC = 34276104 // C相电流8次谐波含量
+	// DICPhaseCCurrentHarmonic9 is a DIC of type PhaseCCurrentHarmonic9.
+	DICPhaseCCurrentHarmonic9 DIC = 34276105 // C相电流9次谐波含量
+	// DICPhaseCCurrentHarmonic10 is a DIC of type PhaseCCurrentHarmonic10.
+	DICPhaseCCurrentHarmonic10 DIC = 34276106 // C相电流10次谐波含量
+	// DICPhaseCCurrentHarmonic11 is a DIC of type PhaseCCurrentHarmonic11.
+	DICPhaseCCurrentHarmonic11 DIC = 34276107 // C相电流11次谐波含量
+	// DICPhaseCCurrentHarmonic12 is a DIC of type PhaseCCurrentHarmonic12.
+	DICPhaseCCurrentHarmonic12 DIC = 34276108 // C相电流12次谐波含量
+	// DICPhaseCCurrentHarmonic13 is a DIC of type PhaseCCurrentHarmonic13.
+	DICPhaseCCurrentHarmonic13 DIC = 34276109 // C相电流13次谐波含量
+	// DICPhaseCCurrentHarmonic14 is a DIC of type PhaseCCurrentHarmonic14.
+	DICPhaseCCurrentHarmonic14 DIC = 34276110 // C相电流14次谐波含量
+	// DICPhaseCCurrentHarmonic15 is a DIC of type PhaseCCurrentHarmonic15.
+	DICPhaseCCurrentHarmonic15 DIC = 34276111 // C相电流15次谐波含量
+	// DICPhaseCCurrentHarmonic16 is a DIC of type PhaseCCurrentHarmonic16.
+	DICPhaseCCurrentHarmonic16 DIC = 34276112 // C相电流16次谐波含量
+	// DICPhaseCCurrentHarmonic17 is a DIC of type PhaseCCurrentHarmonic17.
+	DICPhaseCCurrentHarmonic17 DIC = 34276113 // C相电流17次谐波含量
+	// DICPhaseCCurrentHarmonic18 is a DIC of type PhaseCCurrentHarmonic18.
+	DICPhaseCCurrentHarmonic18 DIC = 34276114 // C相电流18次谐波含量
+	// DICPhaseCCurrentHarmonic19 is a DIC of type PhaseCCurrentHarmonic19.
+	DICPhaseCCurrentHarmonic19 DIC = 34276115 // C相电流19次谐波含量
+	// DICPhaseCCurrentHarmonic20 is a DIC of type PhaseCCurrentHarmonic20.
+	DICPhaseCCurrentHarmonic20 DIC = 34276116 // C相电流20次谐波含量
+	// DICPhaseCCurrentHarmonic21 is a DIC of type PhaseCCurrentHarmonic21.
+	DICPhaseCCurrentHarmonic21 DIC = 34276117 // C相电流21次谐波含量
+	// DICPhaseCCurrentHarmonic is a DIC of type PhaseCCurrentHarmonic.
+	DICPhaseCCurrentHarmonic DIC = 34276351 // C相电流谐波含量数据块
 	// DICABLineVoltage is a DIC of type ABLineVoltage.
 	DICABLineVoltage DIC = 34341120 // AB线电压
 	// DICBCLineVoltage is a DIC of type BCLineVoltage.
@@ -130,26 +469,261 @@ const (
 	DICCALineVoltage DIC = 34341632 // CA线电压
 	// DICLineVoltage is a DIC of type LineVoltage.
 	DICLineVoltage DIC = 34406144 // 线电压数据块
+	// DICNeutralCurrent is a DIC of type NeutralCurrent.
+	DICNeutralCurrent DIC = 41943041 // 零线电流
 	// DICFrequency is a DIC of type Frequency.
 	DICFrequency DIC = 41943042 // 频率
+	// DICAverageActivePower is a DIC of type AverageActivePower.
+	DICAverageActivePower DIC = 41943043 // 一分钟有功总平均功率
+	// DICActiveDemand is a DIC of type ActiveDemand.
+	DICActiveDemand DIC = 41943044 // 当前有功需量
+	// DICReactiveDemand is a DIC of type ReactiveDemand.
+	DICReactiveDemand DIC = 41943045 // 当前无功需量
+	// DICApparentDemand is a DIC of type ApparentDemand.
+	DICApparentDemand DIC = 41943046 // 当前视在需量
+	// DICTemperature is a DIC of type Temperature.
+	DICTemperature DIC = 41943047 // 表内温度
+	// DICClockBatteryVoltage is a DIC of type ClockBatteryVoltage.
+	DICClockBatteryVoltage DIC = 41943048 // 时钟电池电压(内部)
+	// DICReadingBatteryVoltage is a DIC of type ReadingBatteryVoltage.
+	DICReadingBatteryVoltage DIC = 41943049 // 停电抄表电池电压(外部)
+	// DICBatteryRunTime is a DIC of type BatteryRunTime.
+	DICBatteryRunTime DIC = 41943050 // 内部电池工作时间
+	// DICCurrentTariffPrice is a DIC of type CurrentTariffPrice.
+	DICCurrentTariffPrice DIC = 41943051 // 当前阶梯电价
 	// DICTotalOverCurrentCount is a DIC of type TotalOverCurrentCount.
 	// 事件记录数据标识
 	DICTotalOverCurrentCount DIC = 51118080 // 过流总次数，总时间
+	// DICTotalPowerDownCount is a DIC of type TotalPowerDownCount.
+	DICTotalPowerDownCount DIC = 51445760 // 掉电总次数
+	// DICPowerDownRecord is a DIC of type PowerDownRecord.
+	DICPowerDownRecord DIC = 51445761 // 上1次掉电记录
+	// DICTotalProgramCount is a DIC of type TotalProgramCount.
+	DICTotalProgramCount DIC = 53477376 // 编程总次数
+	// DICProgramRecord is a DIC of type ProgramRecord.
+	DICProgramRecord DIC = 53477377 // 上1次编程记录
 	// DICTotalMeterResetCount is a DIC of type TotalMeterResetCount.
 	DICTotalMeterResetCount DIC = 53477632 // 电表清零总次数
 	// DICMeterResetRecord is a DIC of type MeterResetRecord.
 	DICMeterResetRecord DIC = 53477633 // 电表清零记录, 这个返回的是一个对象的结构体
+	// DICTotalDemandResetCount is a DIC of type TotalDemandResetCount.
+	DICTotalDemandResetCount DIC = 53477888 // 需量清零总次数
+	// DICDemandResetRecord is a DIC of type DemandResetRecord.
+	DICDemandResetRecord DIC = 53477889 // 上1次需量清零记录
+	// DICTotalEventResetCount is a DIC of type TotalEventResetCount.
+	DICTotalEventResetCount DIC = 53478144 // 事件清零总次数
+	// DICEventResetRecord is a DIC of type EventResetRecord.
+	DICEventResetRecord DIC = 53478145 // 上1次事件清零记录
+	// DICTotalClockAdjustCount is a DIC of type TotalClockAdjustCount.
+	DICTotalClockAdjustCount DIC = 53478400 // 校时总次数
+	// DICClockAdjustRecord is a DIC of type ClockAdjustRecord.
+	DICClockAdjustRecord DIC = 53478401 // 上1次校时记录
+	// DICTotalMeterCoverOpenCount is a DIC of type TotalMeterCoverOpenCount.
+	DICTotalMeterCoverOpenCount DIC = 53480704 // 开表盖总次数
+	// DICMeterCoverOpenRecord is a DIC of type MeterCoverOpenRecord.
+	DICMeterCoverOpenRecord DIC = 53480705 // 上1次开表盖记录
+	// DICTotalTerminalCoverOpenCount is a DIC of type TotalTerminalCoverOpenCount.
+	DICTotalTerminalCoverOpenCount DIC = 53480960 // 开端钮盒总次数
+	// DICTerminalCoverOpenRecord is a DIC of type TerminalCoverOpenRecord.
+	DICTerminalCoverOpenRecord DIC = 53480961 // 上1次开端钮盒记录
+	// DICLastPurchaseTime is a DIC of type LastPurchaseTime.
+	DICLastPurchaseTime DIC = 53674241 // 上1次购电日期
+	// DICLastPurchaseCount is a DIC of type LastPurchaseCount.
+	DICLastPurchaseCount DIC = 53674497 // 上1次购电后总购电次数
+	// DICLastPurchaseAmount is a DIC of type LastPurchaseAmount.
+	DICLastPurchaseAmount DIC = 53674753 // 上1次购电金额
+	// DICLastPurchaseBalanceBefore is a DIC of type LastPurchaseBalanceBefore.
+	DICLastPurchaseBalanceBefore DIC = 53675009 // 上1次购电前剩余金额
+	// DICLastPurchaseBalanceAfter is a DIC of type LastPurchaseBalanceAfter.
+	DICLastPurchaseBalanceAfter DIC = 53675265 // 上1次购电后剩余金额
+	// DICLastTotalPurchaseAmount is a DIC of type LastTotalPurchaseAmount.
+	DICLastTotalPurchaseAmount DIC = 53675521 // 上1次购电后累计购电金额
 	// DICDateTime is a DIC of type DateTime.
 	// 参变量数据标识
 	DICDateTime DIC = 67109121 // 年月日星期
 	// DICTime is a DIC of type Time.
 	DICTime DIC = 67109122 // 时分秒
+	// DICDemandPeriod is a DIC of type DemandPeriod.
+	DICDemandPeriod DIC = 67109123 // 最大需量周期
+	// DICSlidingTime is a DIC of type SlidingTime.
+	DICSlidingTime DIC = 67109124 // 滑差时间
+	// DICTimeZoneSwitchTime is a DIC of type TimeZoneSwitchTime.
+	DICTimeZoneSwitchTime DIC = 67109126 // 两套时区表切换时间
+	// DICDailyTableSwitchTime is a DIC of type DailyTableSwitchTime.
+	DICDailyTableSwitchTime DIC = 67109127 // 两套日时段表切换时间
+	// DICTariffSwitchTime is a DIC of type TariffSwitchTime.
+	DICTariffSwitchTime DIC = 67109128 // 两套分时费率切换时间
+	// DICStepSwitchTime is a DIC of type StepSwitchTime.
+	DICStepSwitchTime DIC = 67109129 // 两套阶梯切换时间
+	// DICTimeZoneCount is a DIC of type TimeZoneCount.
+	DICTimeZoneCount DIC = 67109377 // 年时区数
+	// DICDailyTableCount is a DIC of type DailyTableCount.
+	DICDailyTableCount DIC = 67109378 // 日时段表数
+	// DICPeriodCount is a DIC of type PeriodCount.
+	DICPeriodCount DIC = 67109379 // 日时段数
+	// DICTariffCount is a DIC of type TariffCount.
+	DICTariffCount DIC = 67109380 // 费率数
+	// DICHolidayCount is a DIC of type HolidayCount.
+	DICHolidayCount DIC = 67109381 // 公共假日数
+	// DICMeterNumber is a DIC of type MeterNumber.
+	DICMeterNumber DIC = 67109890 // 表号
 	// DICAssetManagementCode is a DIC of type AssetManagementCode.
 	DICAssetManagementCode DIC = 67109891 // 资产管理编码
 	// DICActiveConstant is a DIC of type ActiveConstant.
 	DICActiveConstant DIC = 67109897 // 电表有功常数
 	// DICReactiveConstant is a DIC of type ReactiveConstant.
 	DICReactiveConstant DIC = 67109898 // 电表无功常数
+	// DICUserNumber is a DIC of type UserNumber.
+	DICUserNumber DIC = 67109902 // 用户号
+	// DICRunningStatusWord1 is a DIC of type RunningStatusWord1.
+	DICRunningStatusWord1 DIC = 67110145 // 电表运行状态字1
+	// DICRunningStatusWord2 is a DIC of type RunningStatusWord2.
+	DICRunningStatusWord2 DIC = 67110146 // 电表运行状态字2
+	// DICRunningStatusWord3 is a DIC of type RunningStatusWord3.
+	DICRunningStatusWord3 DIC = 67110147 // 电表运行状态字3
+	// DICRunningStatusWord4 is a DIC of type RunningStatusWord4.
+	DICRunningStatusWord4 DIC = 67110148 // 电表运行状态字4, A相故障状态
+	// DICRunningStatusWord5 is a DIC of type RunningStatusWord5.
+	DICRunningStatusWord5 DIC = 67110149 // 电表运行状态字5, B相故障状态
+	// DICRunningStatusWord6 is a DIC of type RunningStatusWord6.
+	DICRunningStatusWord6 DIC = 67110150 // 电表运行状态字6, C相故障状态
+	// DICRunningStatusWord7 is a DIC of type RunningStatusWord7.
+	DICRunningStatusWord7 DIC = 67110151 // 电表运行状态字7, 合相故障状态
+	// DICRunningStatusWord is a DIC of type RunningStatusWord.
+	DICRunningStatusWord DIC = 67110399 // 电表运行状态字数据块
+	// DICAlarmAmount1Limit is a DIC of type AlarmAmount1Limit.
+	DICAlarmAmount1Limit DIC = 67112961 // 报警金额1限值
+	// DICAlarmAmount2Limit is a DIC of type AlarmAmount2Limit.
+	DICAlarmAmount2Limit DIC = 67112962 // 报警金额2限值
+	// DICOverdraftAmountLimit is a DIC of type OverdraftAmountLimit.
+	DICOverdraftAmountLimit DIC = 67112963 // 透支金额限值
+	// DICHoardingAmountLimit is a DIC of type HoardingAmountLimit.
+	DICHoardingAmountLimit DIC = 67112964 // 囤积金额限值
+	// DICCloseAllowedAmountLimit is a DIC of type CloseAllowedAmountLimit.
+	DICCloseAllowedAmountLimit DIC = 67112965 // 合闸允许金额限值
+	// DICActiveReportStatusWord is a DIC of type ActiveReportStatusWord.
+	DICActiveReportStatusWord DIC = 67114241 // 主动上报状态字
+	// DICFirstTimeZoneTable is a DIC of type FirstTimeZoneTable.
+	DICFirstTimeZoneTable DIC = 67174400 // 第一套时区表数据
+	// DICFirstDailyTable1 is a DIC of type FirstDailyTable1.
+	DICFirstDailyTable1 DIC = 67174401 // 第一套第1日时段表数据
+	// DICSecondTimeZoneTable is a DIC of type SecondTimeZoneTable.
+	DICSecondTimeZoneTable DIC = 67239936 // 第二套时区表数据
+	// DICSecondDailyTable1 is a DIC of type SecondDailyTable1.
+	DICSecondDailyTable1 DIC = 67239937 // 第二套第1日时段表数据
+	// DICHoliday1 is a DIC of type Holiday1.
+	DICHoliday1 DIC = 67305473 // 第1公共假日日期及日时段表号
+	// DICPositiveTotalReactiveEnergy is a DIC of type PositiveTotalReactiveEnergy.
+	// 1997专用数据标识, 2007中没有对应的数据标识, 其值为 0xFFFF0000 | old
+	DICPositiveTotalReactiveEnergy DIC = 4294938896 // 正向无功总电能
+	// DICPositiveReactiveEnergyRate1 is a DIC of type PositiveReactiveEnergyRate1.
+	DICPositiveReactiveEnergyRate1 DIC = 4294938897 // 正向无功费率1电能
+	// DICPositiveReactiveEnergyRate2 is a DIC of type PositiveReactiveEnergyRate2.
+	DICPositiveReactiveEnergyRate2 DIC = 4294938898 // 正向无功费率2电能
+	// DICPositiveReactiveEnergyRate3 is a DIC of type PositiveReactiveEnergyRate3.
+	DICPositiveReactiveEnergyRate3 DIC = 4294938899 // 正向无功费率3电能
+	// DICPositiveReactiveEnergyRate4 is a DIC of type PositiveReactiveEnergyRate4.
+	DICPositiveReactiveEnergyRate4 DIC = 4294938900 // 正向无功费率4电能
+	// DICPositiveReactiveEnergy is a DIC of type PositiveReactiveEnergy.
+	DICPositiveReactiveEnergy DIC = 4294938911 // 正向无功电能数据块
+	// DICNegativeTotalReactiveEnergy is a DIC of type NegativeTotalReactiveEnergy.
+	DICNegativeTotalReactiveEnergy DIC = 4294938912 // 反向无功总电能
+	// DICNegativeReactiveEnergyRate1 is a DIC of type NegativeReactiveEnergyRate1.
+	DICNegativeReactiveEnergyRate1 DIC = 4294938913 // 反向无功费率1电能
+	// DICNegativeReactiveEnergyRate2 is a DIC of type NegativeReactiveEnergyRate2.
+	DICNegativeReactiveEnergyRate2 DIC = 4294938914 // 反向无功费率2电能
+	// DICNegativeReactiveEnergyRate3 is a DIC of type NegativeReactiveEnergyRate3.
+	DICNegativeReactiveEnergyRate3 DIC = 4294938915 // 反向无功费率3电能
+	// DICNegativeReactiveEnergyRate4 is a DIC of type NegativeReactiveEnergyRate4.
+	DICNegativeReactiveEnergyRate4 DIC = 4294938916 // 反向无功费率4电能
+	// DICNegativeReactiveEnergy is a DIC of type NegativeReactiveEnergy.
+	DICNegativeReactiveEnergy DIC = 4294938927 // 反向无功电能数据块
+	// DICPositiveReactiveMaxDemand is a DIC of type PositiveReactiveMaxDemand.
+	DICPositiveReactiveMaxDemand DIC = 4294942992 // 正向无功总最大需量
+	// DICNegativeReactiveMaxDemand is a DIC of type NegativeReactiveMaxDemand.
+	DICNegativeReactiveMaxDemand DIC = 4294943008 // 反向无功总最大需量
+	// DICPositiveActiveMaxDemandTime is a DIC of type PositiveActiveMaxDemandTime.
+	DICPositiveActiveMaxDemandTime DIC = 4294946832 // 正向有功总最大需量发生时间
+	// DICPositiveActiveMaxDemandRate1Time is a DIC of type PositiveActiveMaxDemandRate1Time.
+	DICPositiveActiveMaxDemandRate1Time DIC = 4294946833 // 正向有功费率1最大需量发生时间
+	// DICPositiveActiveMaxDemandRate2Time is a DIC of type PositiveActiveMaxDemandRate2Time.
+	DICPositiveActiveMaxDemandRate2Time DIC = 4294946834 // 正向有功费率2最大需量发生时间
+	// DICPositiveActiveMaxDemandRate3Time is a DIC of type PositiveActiveMaxDemandRate3Time.
+	DICPositiveActiveMaxDemandRate3Time DIC = 4294946835 // 正向有功费率3最大需量发生时间
+	// DICPositiveActiveMaxDemandRate4Time is a DIC of type PositiveActiveMaxDemandRate4Time.
+	DICPositiveActiveMaxDemandRate4Time DIC = 4294946836 // 正向有功费率4最大需量发生时间
+	// DICPositiveActiveMaxDemandTimeBlock is a DIC of type PositiveActiveMaxDemandTimeBlock.
+	DICPositiveActiveMaxDemandTimeBlock DIC = 4294946847 // 正向有功最大需量发生时间数据块
+	// DICNegativeActiveMaxDemandTime is a DIC of type NegativeActiveMaxDemandTime.
+	DICNegativeActiveMaxDemandTime DIC = 4294946848 // 反向有功总最大需量发生时间
+	// DICPositiveReactiveMaxDemandTime is a DIC of type PositiveReactiveMaxDemandTime.
+	DICPositiveReactiveMaxDemandTime DIC = 4294947088 // 正向无功总最大需量发生时间
+	// DICNegativeReactiveMaxDemandTime is a DIC of type NegativeReactiveMaxDemandTime.
+	DICNegativeReactiveMaxDemandTime DIC = 4294947104 // 反向无功总最大需量发生时间
+	// DICLastProgramTime is a DIC of type LastProgramTime.
+	DICLastProgramTime DIC = 4294947344 // 最近一次编程时间
+	// DICLastDemandResetTime is a DIC of type LastDemandResetTime.
+	DICLastDemandResetTime DIC = 4294947345 // 最近一次最大需量清零时间
+	// DICTotalPhaseBreakCount is a DIC of type TotalPhaseBreakCount.
+	DICTotalPhaseBreakCount DIC = 4294947600 // 总断相次数
+	// DICPhaseABreakCount is a DIC of type PhaseABreakCount.
+	DICPhaseABreakCount DIC = 4294947601 // A相断相次数
+	// DICPhaseBBreakCount is a DIC of type PhaseBBreakCount.
+	DICPhaseBBreakCount DIC = 4294947602 // B相断相次数
+	// DICPhaseCBreakCount is a DIC of type PhaseCBreakCount.
+	DICPhaseCBreakCount DIC = 4294947603 // C相断相次数
+	// DICPhaseBreakCount is a DIC of type PhaseBreakCount.
+	DICPhaseBreakCount DIC = 4294947615 // 断相次数数据块
+	// DICTotalPhaseBreakTime is a DIC of type TotalPhaseBreakTime.
+	DICTotalPhaseBreakTime DIC = 4294947616 // 总断相时间累计值
+	// DICPhaseABreakTime is a DIC of type PhaseABreakTime.
+	DICPhaseABreakTime DIC = 4294947617 // A相断相时间累计值
+	// DICPhaseBBreakTime is a DIC of type PhaseBBreakTime.
+	DICPhaseBBreakTime DIC = 4294947618 // B相断相时间累计值
+	// DICPhaseCBreakTime is a DIC of type PhaseCBreakTime.
+	DICPhaseCBreakTime DIC = 4294947619 // C相断相时间累计值
+	// DICPhaseBreakTime is a DIC of type PhaseBreakTime.
+	DICPhaseBreakTime DIC = 4294947631 // 断相时间累计值数据块
+	// DICTotalPhaseLastBreakStartTime is a DIC of type TotalPhaseLastBreakStartTime.
+	DICTotalPhaseLastBreakStartTime DIC = 4294947632 // 总最近一次断相起始时刻
+	// DICPhaseALastBreakStartTime is a DIC of type PhaseALastBreakStartTime.
+	DICPhaseALastBreakStartTime DIC = 4294947633 // A相最近一次断相起始时刻
+	// DICPhaseBLastBreakStartTime is a DIC of type PhaseBLastBreakStartTime.
+	DICPhaseBLastBreakStartTime DIC = 4294947634 // B相最近一次断相起始时刻
+	// DICPhaseCLastBreakStartTime is a DIC of type PhaseCLastBreakStartTime.
+	DICPhaseCLastBreakStartTime DIC = 4294947635 // C相最近一次断相起始时刻
+	// DICPhaseLastBreakStartTime is a DIC of type PhaseLastBreakStartTime.
+	DICPhaseLastBreakStartTime DIC = 4294947647 // 最近一次断相起始时刻数据块
+	// DICTotalPhaseLastBreakEndTime is a DIC of type TotalPhaseLastBreakEndTime.
+	DICTotalPhaseLastBreakEndTime DIC = 4294947648 // 总最近一次断相结束时刻
+	// DICPhaseALastBreakEndTime is a DIC of type PhaseALastBreakEndTime.
+	DICPhaseALastBreakEndTime DIC = 4294947649 // A相最近一次断相结束时刻
+	// DICPhaseBLastBreakEndTime is a DIC of type PhaseBLastBreakEndTime.
+	DICPhaseBLastBreakEndTime DIC = 4294947650 // B相最近一次断相结束时刻
+	// DICPhaseCLastBreakEndTime is a DIC of type PhaseCLastBreakEndTime.
+	DICPhaseCLastBreakEndTime DIC = 4294947651 // C相最近一次断相结束时刻
+	// DICPhaseLastBreakEndTime is a DIC of type PhaseLastBreakEndTime.
+	DICPhaseLastBreakEndTime DIC = 4294947663 // 最近一次断相结束时刻数据块
+	// DICMeterStatusWord is a DIC of type MeterStatusWord.
+	DICMeterStatusWord DIC = 4294950944 // 电表运行状态字
+	// DICGridStatusWord is a DIC of type GridStatusWord.
+	DICGridStatusWord DIC = 4294950945 // 电网状态字
+)
+
+const (
+	// DtuEventConnect is a DtuEvent of type Connect.
+	DtuEventConnect DtuEvent = iota // dtu注册成功
+	// DtuEventDisconnect is a DtuEvent of type Disconnect.
+	DtuEventDisconnect // dtu断开连接
+)
+
+const (
+	// EchoModeOff is an EchoMode of type Off.
+	EchoModeOff EchoMode = iota // 不回显
+	// EchoModeOn is an EchoMode of type On.
+	EchoModeOn // 回显发送的数据, 读取并校验回显后再解析应答
+	// EchoModeAuto is an EchoMode of type Auto.
+	EchoModeAuto // 第一次请求时自动检测是否回显
 )
 
 const (
@@ -169,6 +743,25 @@ const (
 	ErrorCodeOTHER ErrorCode = 1 // 其他错误
 )
 
+const (
+	// EventKindPowerDown is an EventKind of type PowerDown.
+	EventKindPowerDown EventKind = 51445760 // 掉电
+	// EventKindProgram is an EventKind of type Program.
+	EventKindProgram EventKind = 53477376 // 编程
+	// EventKindMeterReset is an EventKind of type MeterReset.
+	EventKindMeterReset EventKind = 53477632 // 电表清零
+	// EventKindDemandReset is an EventKind of type DemandReset.
+	EventKindDemandReset EventKind = 53477888 // 需量清零
+	// EventKindEventReset is an EventKind of type EventReset.
+	EventKindEventReset EventKind = 53478144 // 事件清零
+	// EventKindClockAdjust is an EventKind of type ClockAdjust.
+	EventKindClockAdjust EventKind = 53478400 // 校时
+	// EventKindMeterCoverOpen is an EventKind of type MeterCoverOpen.
+	EventKindMeterCoverOpen EventKind = 53480704 // 开表盖
+	// EventKindTerminalCoverOpen is an EventKind of type TerminalCoverOpen.
+	EventKindTerminalCoverOpen EventKind = 53480960 // 开端钮盒
+)
+
 const (
 	// PV1997 is a P of type V1997.
 	PV1997 P = iota
@@ -176,6 +769,96 @@ const (
 	PV2007
 )
 
+const (
+	// PointTotalActiveEnergy is a Point of type TotalActiveEnergy.
+	// 电能量
+	PointTotalActiveEnergy Point = iota // 组合有功总电能
+	// PointPositiveTotalActiveEnergy is a Point of type PositiveTotalActiveEnergy.
+	PointPositiveTotalActiveEnergy // 正向有功总电能
+	// PointNegativeTotalActiveEnergy is a Point of type NegativeTotalActiveEnergy.
+	PointNegativeTotalActiveEnergy // 反向有功总电能
+	// PointTotalReactiveEnergy1 is a Point of type TotalReactiveEnergy1.
+	PointTotalReactiveEnergy1 // 组合无功1总电能
+	// PointTotalReactiveEnergy2 is a Point of type TotalReactiveEnergy2.
+	PointTotalReactiveEnergy2 // 组合无功2总电能
+	// PointFirstQuadrantReactiveEnergy is a Point of type FirstQuadrantReactiveEnergy.
+	PointFirstQuadrantReactiveEnergy // 第一象限无功电能
+	// PointSecondQuadrantReactiveEnergy is a Point of type SecondQuadrantReactiveEnergy.
+	PointSecondQuadrantReactiveEnergy // 第二象限无功电能
+	// PointThirdQuadrantReactiveEnergy is a Point of type ThirdQuadrantReactiveEnergy.
+	PointThirdQuadrantReactiveEnergy // 第三象限无功电能
+	// PointFourthQuadrantReactiveEnergy is a Point of type FourthQuadrantReactiveEnergy.
+	PointFourthQuadrantReactiveEnergy // 第四象限无功电能
+	// PointPositiveActiveMaxDemand is a Point of type PositiveActiveMaxDemand.
+	// 需量
+	PointPositiveActiveMaxDemand // 正向有功总最大需量
+	// PointNegativeActiveMaxDemand is a Point of type NegativeActiveMaxDemand.
+	PointNegativeActiveMaxDemand // 反向有功总最大需量
+	// PointPhaseAVoltage is a Point of type PhaseAVoltage.
+	// 变量
+	PointPhaseAVoltage // A相电压
+	// PointPhaseBVoltage is a Point of type PhaseBVoltage.
+	PointPhaseBVoltage // B相电压
+	// PointPhaseCVoltage is a Point of type PhaseCVoltage.
+	PointPhaseCVoltage // C相电压
+	// PointPhaseACurrent is a Point of type PhaseACurrent.
+	PointPhaseACurrent // A相电流
+	// PointPhaseBCurrent is a Point of type PhaseBCurrent.
+	PointPhaseBCurrent // B相电流
+	// PointPhaseCCurrent is a Point of type PhaseCCurrent.
+	PointPhaseCCurrent // C相电流
+	// PointTotalActivePower is a Point of type TotalActivePower.
+	PointTotalActivePower // 总有功功率
+	// PointPhaseAActivePower is a Point of type PhaseAActivePower.
+	PointPhaseAActivePower // A相有功功率
+	// PointPhaseBActivePower is a Point of type PhaseBActivePower.
+	PointPhaseBActivePower // B相有功功率
+	// PointPhaseCActivePower is a Point of type PhaseCActivePower.
+	PointPhaseCActivePower // C相有功功率
+	// PointTotalReactivePower is a Point of type TotalReactivePower.
+	PointTotalReactivePower // 总无功功率
+	// PointPhaseAReactivePower is a Point of type PhaseAReactivePower.
+	PointPhaseAReactivePower // A相无功功率
+	// PointPhaseBReactivePower is a Point of type PhaseBReactivePower.
+	PointPhaseBReactivePower // B相无功功率
+	// PointPhaseCReactivePower is a Point of type PhaseCReactivePower.
+	PointPhaseCReactivePower // C相无功功率
+	// PointTotalPowerFactor is a Point of type TotalPowerFactor.
+	PointTotalPowerFactor // 总功率因素
+	// PointPhaseAPowerFactor is a Point of type PhaseAPowerFactor.
+	PointPhaseAPowerFactor // A相功率因素
+	// PointPhaseBPowerFactor is a Point of type PhaseBPowerFactor.
+	PointPhaseBPowerFactor // B相功率因素
+	// PointPhaseCPowerFactor is a Point of type PhaseCPowerFactor.
+	PointPhaseCPowerFactor // C相功率因素
+	// PointFrequency is a Point of type Frequency.
+	PointFrequency // 电网频率
+	// PointTemperature is a Point of type Temperature.
+	PointTemperature // 表内温度
+	// PointActiveConstant is a Point of type ActiveConstant.
+	// 参变量
+	PointActiveConstant // 电表有功常数
+	// PointReactiveConstant is a Point of type ReactiveConstant.
+	PointReactiveConstant // 电表无功常数
+	// PointTotalProgramCount is a Point of type TotalProgramCount.
+	PointTotalProgramCount // 编程总次数
+	// PointTotalDemandResetCount is a Point of type TotalDemandResetCount.
+	PointTotalDemandResetCount // 需量清零总次数
+	// PointBatteryRunTime is a Point of type BatteryRunTime.
+	PointBatteryRunTime // 电池工作时间
+)
+
+const (
+	// PriorityBackground is a Priority of type Background.
+	PriorityBackground Priority = iota // 后台轮询
+	// PriorityNormal is a Priority of type Normal.
+	PriorityNormal // 普通读数据
+	// PriorityInteractive is a Priority of type Interactive.
+	PriorityInteractive // 交互操作
+	// PriorityControl is a Priority of type Control.
+	PriorityControl // 控制命令, 如写数据
+)
+
 const (
 	// StateUnknown is a State of type Unknown.
 	StateUnknown State = iota
@@ -284,62 +967,340 @@ func ParseC(value string) (C, error) {
 
 var ErrInvalidDIC = errors.New("not a valid DIC")
 
-var _DICName = "TotalActiveEnergyPositiveTotalActiveEnergyNegativeTotalActiveEnergyTotalReactiveEnergy1TotalReactiveEnergy2FirstQuadrantReactiveEnergySecondQuadrantReactiveEnergyThirdQuadrantReactiveEnergyFourthQuadrantReactiveEnergyPositiveTotalApparentEnergyNegativeTotalApparentEnergyAssociatedTotalElectricEnergyPhaseAVoltagePhaseBVoltagePhaseCVoltageVoltagePhaseACurrentPhaseBCurrentPhaseCCurrentCurrentTotalActivePowerPhaseAActivePowerPhaseBActivePowerPhaseCActivePowerActivePowerTotalReactivePowerPhaseAReactivePowerPhaseBReactivePowerPhaseCReactivePowerReactivePowerTotalApparentPowerPhaseAApparentPowerPhaseBApparentPowerPhaseCApparentPowerApparentPowerTotalPowerFactorPhaseAPowerFactorPhaseBPowerFactorPhaseCPowerFactorPowerFactorABLineVoltageBCLineVoltageCALineVoltageLineVoltageFrequencyTotalOverCurrentCountTotalMeterResetCountMeterResetRecordDateTimeTimeAssetManagementCodeActiveConstantReactiveConstant"
+var _DICName = "TotalActiveEnergyPositiveTotalActiveEnergyPositiveActiveEnergyRate1PositiveActiveEnergyRate2PositiveActiveEnergyRate3PositiveActiveEnergyRate4PositiveActiveEnergyNegativeTotalActiveEnergyNegativeActiveEnergyRate1NegativeActiveEnergyRate2NegativeActiveEnergyRate3NegativeActiveEnergyRate4NegativeActiveEnergyTotalReactiveEnergy1ReactiveEnergy1Rate1ReactiveEnergy1Rate2ReactiveEnergy1Rate3ReactiveEnergy1Rate4ReactiveEnergy1TotalReactiveEnergy2ReactiveEnergy2Rate1ReactiveEnergy2Rate2ReactiveEnergy2Rate3ReactiveEnergy2Rate4ReactiveEnergy2FirstQuadrantReactiveEnergySecondQuadrantReactiveEnergyThirdQuadrantReactiveEnergyFourthQuadrantReactiveEnergyPositiveTotalApparentEnergyNegativeTotalApparentEnergyAssociatedTotalElectricEnergyRemainingEnergyOverdraftEnergyRemainingAmountOverdraftAmountPositiveActiveMaxDemandPositiveActiveMaxDemandRate1PositiveActiveMaxDemandRate2PositiveActiveMaxDemandRate3PositiveActiveMaxDemandRate4PositiveActiveMaxDemandBlockNegativeActiveMaxDemandReactiveMaxDemand1ReactiveMaxDemand2PhaseAVoltagePhaseBVoltagePhaseCVoltageVoltagePhaseACurrentPhaseBCurrentPhaseCCurrentCurrentTotalActivePowerPhaseAActivePowerPhaseBActivePowerPhaseCActivePowerActivePowerTotalReactivePowerPhaseAReactivePowerPhaseBReactivePowerPhaseCReactivePowerReactivePowerTotalApparentPowerPhaseAApparentPowerPhaseBApparentPowerPhaseCApparentPowerApparentPowerTotalPowerFactorPhaseAPowerFactorPhaseBPowerFactorPhaseCPowerFactorPowerFactorPhaseAAnglePhaseBAnglePhaseCAnglePhaseAnglePhaseAVoltageTHDPhaseAVoltageHarmonic2PhaseAVoltageHarmonic3PhaseAVoltageHarmonic4PhaseAVoltageHarmonic5PhaseAVoltageHarmonic6PhaseAVoltageHarmonic7PhaseAVoltageHarmonic8PhaseAVoltageHarmonic9PhaseAVoltageHarmonic10PhaseAVoltageHarmonic11PhaseAVoltageHarmonic12PhaseAVoltageHarmonic13PhaseAVoltageHarmonic14PhaseAVoltageHarmonic15PhaseAVoltageHarmonic16PhaseAVoltageHarmonic17PhaseAVoltageHarmonic18PhaseAVoltageHarmonic19PhaseAVoltageHarmonic20PhaseAVoltageHarmonic21PhaseAVoltageHarmonicPhaseBVoltageTHDPhaseBVoltageHarmonic2PhaseBVoltageHarmonic3PhaseBVoltageHarmonic4PhaseBVoltageHarmonic5PhaseBVoltageHarmonic6PhaseBVoltageHarmonic7PhaseBVoltageHarmonic8PhaseBVoltageHarmonic9PhaseBVoltageHarmonic10PhaseBVoltageHarmonic11PhaseBVoltageHarmonic12PhaseBVoltageHarmonic13PhaseBVoltageHarmonic14PhaseBVoltageHarmonic15PhaseBVoltageHarmonic16PhaseBVoltageHarmonic17PhaseBVoltageHarmonic18PhaseBVoltageHarmonic19PhaseBVoltageHarmonic20PhaseBVoltageHarmonic21PhaseBVoltageHarmonicPhaseCVoltageTHDPhaseCVoltageHarmonic2PhaseCVoltageHarmonic3PhaseCVoltageHarmonic4PhaseCVoltageHarmonic5PhaseCVoltageHarmonic6PhaseCVoltageHarmonic7PhaseCVoltageHarmonic8PhaseCVoltageHarmonic9PhaseCVoltageHarmonic10PhaseCVoltageHarmonic11PhaseCVoltageHarmonic12PhaseCVoltageHarmonic13PhaseCVoltageHarmonic14PhaseCVoltageHarmonic15PhaseCVoltageHarmonic16PhaseCVoltageHarmonic17PhaseCVoltageHarmonic18PhaseCVoltageHarmonic19PhaseCVoltageHarmonic20PhaseCVoltageHarmonic21PhaseCVoltageHarmonicPhaseACurrentTHDPhaseACurrentHarmonic2PhaseACurrentHarmonic3PhaseACurrentHarmonic4PhaseACurrentHarmonic5PhaseACurrentHarmonic6PhaseACurrentHarmonic7PhaseACurrentHarmonic8PhaseACurrentHarmonic9PhaseACurrentHarmonic10PhaseACurrentHarmonic11PhaseACurrentHarmonic12PhaseACurrentHarmonic13PhaseACurrentHarmonic14PhaseACurrentHarmonic15PhaseACurrentHarmonic16PhaseACurrentHarmonic17PhaseACurrentHarmonic18PhaseACurrentHarmonic19PhaseACurrentHarmonic20PhaseACurrentHarmonic21PhaseACurrentHarmonicPhaseBCurrentTHDPhaseBCurrentHarmonic2PhaseBCurrentHarmonic3PhaseBCurrentHarmonic4PhaseBCurrentHarmonic5PhaseBCurrentHarmonic6PhaseBCurrentHarmonic7PhaseBCurrentHarmonic8PhaseBCurrentHarmonic9PhaseBCurrentHarmonic10PhaseBCurrentHarmonic11PhaseBCurrentHarmonic12PhaseBCurrentHarmonic13PhaseBCurrentHarmonic14PhaseBCurrentHarmonic15PhaseBCurrentHarmonic16PhaseBCurrentHarmonic17PhaseBCurrentHarmonic18PhaseBCurrentHarmonic19PhaseBCurrentHarmonic20PhaseBCurrentHarmonic21PhaseBCurrentHarmonicPhaseCCurrentTHDPhaseCCurrentHarmonic2PhaseCCurrentHarmonic3PhaseCCurrentHarmonic4PhaseCCurrentHarmonic5PhaseCCurrentHarmonic6PhaseCCurrentHarmonic7PhaseCCurrentHarmonic8PhaseCCurrentHarmonic9PhaseCCurrentHarmonic10PhaseCCurrentHarmonic11PhaseCCurrentHarmonic12PhaseCCurrentHarmonic13PhaseCCurrentHarmonic14PhaseCCurrentHarmonic15PhaseCCurrentHarmonic16PhaseCCurrentHarmonic17PhaseCCurrentHarmonic18PhaseCCurrentHarmonic19PhaseCCurrentHarmonic20PhaseCCurrentHarmonic21PhaseCCurrentHarmonicABLineVoltageBCLineVoltageCALineVoltageLineVoltageNeutralCurrentFrequencyAverageActivePowerActiveDemandReactiveDemandApparentDemandTemperatureClockBatteryVoltageReadingBatteryVoltageBatteryRunTimeCurrentTariffPriceTotalOverCurrentCountTotalPowerDownCountPowerDownRecordTotalProgramCountProgramRecordTotalMeterResetCountMeterResetRecordTotalDemandResetCountDemandResetRecordTotalEventResetCountEventResetRecordTotalClockAdjustCountClockAdjustRecordTotalMeterCoverOpenCountMeterCoverOpenRecordTotalTerminalCoverOpenCountTerminalCoverOpenRecordLastPurchaseTimeLastPurchaseCountLastPurchaseAmountLastPurchaseBalanceBeforeLastPurchaseBalanceAfterLastTotalPurchaseAmountDateTimeTimeDemandPeriodSlidingTimeTimeZoneSwitchTimeDailyTableSwitchTimeTariffSwitchTimeStepSwitchTimeTimeZoneCountDailyTableCountPeriodCountTariffCountHolidayCountMeterNumberAssetManagementCodeActiveConstantReactiveConstantUserNumberRunningStatusWord1RunningStatusWord2RunningStatusWord3RunningStatusWord4RunningStatusWord5RunningStatusWord6RunningStatusWord7RunningStatusWordAlarmAmount1LimitAlarmAmount2LimitOverdraftAmountLimitHoardingAmountLimitCloseAllowedAmountLimitActiveReportStatusWordFirstTimeZoneTableFirstDailyTable1SecondTimeZoneTableSecondDailyTable1Holiday1PositiveTotalReactiveEnergyPositiveReactiveEnergyRate1PositiveReactiveEnergyRate2PositiveReactiveEnergyRate3PositiveReactiveEnergyRate4PositiveReactiveEnergyNegativeTotalReactiveEnergyNegativeReactiveEnergyRate1NegativeReactiveEnergyRate2NegativeReactiveEnergyRate3NegativeReactiveEnergyRate4NegativeReactiveEnergyPositiveReactiveMaxDemandNegativeReactiveMaxDemandPositiveActiveMaxDemandTimePositiveActiveMaxDemandRate1TimePositiveActiveMaxDemandRate2TimePositiveActiveMaxDemandRate3TimePositiveActiveMaxDemandRate4TimePositiveActiveMaxDemandTimeBlockNegativeActiveMaxDemandTimePositiveReactiveMaxDemandTimeNegativeReactiveMaxDemandTimeLastProgramTimeLastDemandResetTimeTotalPhaseBreakCountPhaseABreakCountPhaseBBreakCountPhaseCBreakCountPhaseBreakCountTotalPhaseBreakTimePhaseABreakTimePhaseBBreakTimePhaseCBreakTimePhaseBreakTimeTotalPhaseLastBreakStartTimePhaseALastBreakStartTimePhaseBLastBreakStartTimePhaseCLastBreakStartTimePhaseLastBreakStartTimeTotalPhaseLastBreakEndTimePhaseALastBreakEndTimePhaseBLastBreakEndTimePhaseCLastBreakEndTimePhaseLastBreakEndTimeMeterStatusWordGridStatusWord"
 
 var _DICMapName = map[DIC]string{
-	DICTotalActiveEnergy:             _DICName[0:17],
-	DICPositiveTotalActiveEnergy:     _DICName[17:42],
-	DICNegativeTotalActiveEnergy:     _DICName[42:67],
-	DICTotalReactiveEnergy1:          _DICName[67:87],
-	DICTotalReactiveEnergy2:          _DICName[87:107],
-	DICFirstQuadrantReactiveEnergy:   _DICName[107:134],
-	DICSecondQuadrantReactiveEnergy:  _DICName[134:162],
-	DICThirdQuadrantReactiveEnergy:   _DICName[162:189],
-	DICFourthQuadrantReactiveEnergy:  _DICName[189:217],
-	DICPositiveTotalApparentEnergy:   _DICName[217:244],
-	DICNegativeTotalApparentEnergy:   _DICName[244:271],
-	DICAssociatedTotalElectricEnergy: _DICName[271:300],
-	DICPhaseAVoltage:                 _DICName[300:313],
-	DICPhaseBVoltage:                 _DICName[313:326],
-	DICPhaseCVoltage:                 _DICName[326:339],
-	DICVoltage:                       _DICName[339:346],
-	DICPhaseACurrent:                 _DICName[346:359],
-	DICPhaseBCurrent:                 _DICName[359:372],
-	DICPhaseCCurrent:                 _DICName[372:385],
-	DICCurrent:                       _DICName[385:392],
-	DICTotalActivePower:              _DICName[392:408],
-	DICPhaseAActivePower:             _DICName[408:425],
-	DICPhaseBActivePower:             _DICName[425:442],
-	DICPhaseCActivePower:             _DICName[442:459],
-	DICActivePower:                   _DICName[459:470],
-	DICTotalReactivePower:            _DICName[470:488],
-	DICPhaseAReactivePower:           _DICName[488:507],
-	DICPhaseBReactivePower:           _DICName[507:526],
-	DICPhaseCReactivePower:           _DICName[526:545],
-	DICReactivePower:                 _DICName[545:558],
-	DICTotalApparentPower:            _DICName[558:576],
-	DICPhaseAApparentPower:           _DICName[576:595],
-	DICPhaseBApparentPower:           _DICName[595:614],
-	DICPhaseCApparentPower:           _DICName[614:633],
-	DICApparentPower:                 _DICName[633:646],
-	DICTotalPowerFactor:              _DICName[646:662],
-	DICPhaseAPowerFactor:             _DICName[662:679],
-	DICPhaseBPowerFactor:             _DICName[679:696],
-	DICPhaseCPowerFactor:             _DICName[696:713],
-	DICPowerFactor:                   _DICName[713:724],
-	DICABLineVoltage:                 _DICName[724:737],
-	DICBCLineVoltage:                 _DICName[737:750],
-	DICCALineVoltage:                 _DICName[750:763],
-	DICLineVoltage:                   _DICName[763:774],
-	DICFrequency:                     _DICName[774:783],
-	DICTotalOverCurrentCount:         _DICName[783:804],
-	DICTotalMeterResetCount:          _DICName[804:824],
-	DICMeterResetRecord:              _DICName[824:840],
-	DICDateTime:                      _DICName[840:848],
-	DICTime:                          _DICName[848:852],
-	DICAssetManagementCode:           _DICName[852:871],
-	DICActiveConstant:                _DICName[871:885],
-	DICReactiveConstant:              _DICName[885:901],
+	DICTotalActiveEnergy:                _DICName[0:17],
+	DICPositiveTotalActiveEnergy:        _DICName[17:42],
+	DICPositiveActiveEnergyRate1:        _DICName[42:67],
+	DICPositiveActiveEnergyRate2:        _DICName[67:92],
+	DICPositiveActiveEnergyRate3:        _DICName[92:117],
+	DICPositiveActiveEnergyRate4:        _DICName[117:142],
+	DICPositiveActiveEnergy:             _DICName[142:162],
+	DICNegativeTotalActiveEnergy:        _DICName[162:187],
+	DICNegativeActiveEnergyRate1:        _DICName[187:212],
+	DICNegativeActiveEnergyRate2:        _DICName[212:237],
+	DICNegativeActiveEnergyRate3:        _DICName[237:262],
+	DICNegativeActiveEnergyRate4:        _DICName[262:287],
+	DICNegativeActiveEnergy:             _DICName[287:307],
+	DICTotalReactiveEnergy1:             _DICName[307:327],
+	DICReactiveEnergy1Rate1:             _DICName[327:347],
+	DICReactiveEnergy1Rate2:             _DICName[347:367],
+	DICReactiveEnergy1Rate3:             _DICName[367:387],
+	DICReactiveEnergy1Rate4:             _DICName[387:407],
+	DICReactiveEnergy1:                  _DICName[407:422],
+	DICTotalReactiveEnergy2:             _DICName[422:442],
+	DICReactiveEnergy2Rate1:             _DICName[442:462],
+	DICReactiveEnergy2Rate2:             _DICName[462:482],
+	DICReactiveEnergy2Rate3:             _DICName[482:502],
+	DICReactiveEnergy2Rate4:             _DICName[502:522],
+	DICReactiveEnergy2:                  _DICName[522:537],
+	DICFirstQuadrantReactiveEnergy:      _DICName[537:564],
+	DICSecondQuadrantReactiveEnergy:     _DICName[564:592],
+	DICThirdQuadrantReactiveEnergy:      _DICName[592:619],
+	DICFourthQuadrantReactiveEnergy:     _DICName[619:647],
+	DICPositiveTotalApparentEnergy:      _DICName[647:674],
+	DICNegativeTotalApparentEnergy:      _DICName[674:701],
+	DICAssociatedTotalElectricEnergy:    _DICName[701:730],
+	DICRemainingEnergy:                  _DICName[730:745],
+	DICOverdraftEnergy:                  _DICName[745:760],
+	DICRemainingAmount:                  _DICName[760:775],
+	DICOverdraftAmount:                  _DICName[775:790],
+	DICPositiveActiveMaxDemand:          _DICName[790:813],
+	DICPositiveActiveMaxDemandRate1:     _DICName[813:841],
+	DICPositiveActiveMaxDemandRate2:     _DICName[841:869],
+	DICPositiveActiveMaxDemandRate3:     _DICName[869:897],
+	DICPositiveActiveMaxDemandRate4:     _DICName[897:925],
+	DICPositiveActiveMaxDemandBlock:     _DICName[925:953],
+	DICNegativeActiveMaxDemand:          _DICName[953:976],
+	DICReactiveMaxDemand1:               _DICName[976:994],
+	DICReactiveMaxDemand2:               _DICName[994:1012],
+	DICPhaseAVoltage:                    _DICName[1012:1025],
+	DICPhaseBVoltage:                    _DICName[1025:1038],
+	DICPhaseCVoltage:                    _DICName[1038:1051],
+	DICVoltage:                          _DICName[1051:1058],
+	DICPhaseACurrent:                    _DICName[1058:1071],
+	DICPhaseBCurrent:                    _DICName[1071:1084],
+	DICPhaseCCurrent:                    _DICName[1084:1097],
+	DICCurrent:                          _DICName[1097:1104],
+	DICTotalActivePower:                 _DICName[1104:1120],
+	DICPhaseAActivePower:                _DICName[1120:1137],
+	DICPhaseBActivePower:                _DICName[1137:1154],
+	DICPhaseCActivePower:                _DICName[1154:1171],
+	DICActivePower:                      _DICName[1171:1182],
+	DICTotalReactivePower:               _DICName[1182:1200],
+	DICPhaseAReactivePower:              _DICName[1200:1219],
+	DICPhaseBReactivePower:              _DICName[1219:1238],
+	DICPhaseCReactivePower:              _DICName[1238:1257],
+	DICReactivePower:                    _DICName[1257:1270],
+	DICTotalApparentPower:               _DICName[1270:1288],
+	DICPhaseAApparentPower:              _DICName[1288:1307],
+	DICPhaseBApparentPower:              _DICName[1307:1326],
+	DICPhaseCApparentPower:              _DICName[1326:1345],
+	DICApparentPower:                    _DICName[1345:1358],
+	DICTotalPowerFactor:                 _DICName[1358:1374],
+	DICPhaseAPowerFactor:                _DICName[1374:1391],
+	DICPhaseBPowerFactor:                _DICName[1391:1408],
+	DICPhaseCPowerFactor:                _DICName[1408:1425],
+	DICPowerFactor:                      _DICName[1425:1436],
+	DICPhaseAAngle:                      _DICName[1436:1447],
+	DICPhaseBAngle:                      _DICName[1447:1458],
+	DICPhaseCAngle:                      _DICName[1458:1469],
+	DICPhaseAngle:                       _DICName[1469:1479],
+	DICPhaseAVoltageTHD:                 _DICName[1479:1495],
+	DICPhaseAVoltageHarmonic2:           _DICName[1495:1517],
+	DICPhaseAVoltageHarmonic3:           _DICName[1517:1539],
+	DICPhaseAVoltageHarmonic4:           _DICName[1539:1561],
+	DICPhaseAVoltageHarmonic5:           _DICName[1561:1583],
+	DICPhaseAVoltageHarmonic6:           _DICName[1583:1605],
+	DICPhaseAVoltageHarmonic7:           _DICName[1605:1627],
+	DICPhaseAVoltageHarmonic8:           _DICName[1627:1649],
+	DICPhaseAVoltageHarmonic9:           _DICName[1649:1671],
+	DICPhaseAVoltageHarmonic10:          _DICName[1671:1694],
+	DICPhaseAVoltageHarmonic11:          _DICName[1694:1717],
+	DICPhaseAVoltageHarmonic12:          _DICName[1717:1740],
+	DICPhaseAVoltageHarmonic13:          _DICName[1740:1763],
+	DICPhaseAVoltageHarmonic14:          _DICName[1763:1786],
+	DICPhaseAVoltageHarmonic15:          _DICName[1786:1809],
+	DICPhaseAVoltageHarmonic16:          _DICName[1809:1832],
+	DICPhaseAVoltageHarmonic17:          _DICName[1832:1855],
+	DICPhaseAVoltageHarmonic18:          _DICName[1855:1878],
+	DICPhaseAVoltageHarmonic19:          _DICName[1878:1901],
+	DICPhaseAVoltageHarmonic20:          _DICName[1901:1924],
+	DICPhaseAVoltageHarmonic21:          _DICName[1924:1947],
+	DICPhaseAVoltageHarmonic:            _DICName[1947:1968],
+	DICPhaseBVoltageTHD:                 _DICName[1968:1984],
+	DICPhaseBVoltageHarmonic2:           _DICName[1984:2006],
+	DICPhaseBVoltageHarmonic3:           _DICName[2006:2028],
+	DICPhaseBVoltageHarmonic4:           _DICName[2028:2050],
+	DICPhaseBVoltageHarmonic5:           _DICName[2050:2072],
+	DICPhaseBVoltageHarmonic6:           _DICName[2072:2094],
+	DICPhaseBVoltageHarmonic7:           _DICName[2094:2116],
+	DICPhaseBVoltageHarmonic8:           _DICName[2116:2138],
+	DICPhaseBVoltageHarmonic9:           _DICName[2138:2160],
+	DICPhaseBVoltageHarmonic10:          _DICName[2160:2183],
+	DICPhaseBVoltageHarmonic11:          _DICName[2183:2206],
+	DICPhaseBVoltageHarmonic12:          _DICName[2206:2229],
+	DICPhaseBVoltageHarmonic13:          _DICName[2229:2252],
+	DICPhaseBVoltageHarmonic14:          _DICName[2252:2275],
+	DICPhaseBVoltageHarmonic15:          _DICName[2275:2298],
+	DICPhaseBVoltageHarmonic16:          _DICName[2298:2321],
+	DICPhaseBVoltageHarmonic17:          _DICName[2321:2344],
+	DICPhaseBVoltageHarmonic18:          _DICName[2344:2367],
+	DICPhaseBVoltageHarmonic19:          _DICName[2367:2390],
+	DICPhaseBVoltageHarmonic20:          _DICName[2390:2413],
+	DICPhaseBVoltageHarmonic21:          _DICName[2413:2436],
+	DICPhaseBVoltageHarmonic:            _DICName[2436:2457],
+	DICPhaseCVoltageTHD:                 _DICName[2457:2473],
+	DICPhaseCVoltageHarmonic2:           _DICName[2473:2495],
+	DICPhaseCVoltageHarmonic3:           _DICName[2495:2517],
+	DICPhaseCVoltageHarmonic4:           _DICName[2517:2539],
+	DICPhaseCVoltageHarmonic5:           _DICName[2539:2561],
+	DICPhaseCVoltageHarmonic6:           _DICName[2561:2583],
+	DICPhaseCVoltageHarmonic7:           _DICName[2583:2605],
+	DICPhaseCVoltageHarmonic8:           _DICName[2605:2627],
+	DICPhaseCVoltageHarmonic9:           _DICName[2627:2649],
+	DICPhaseCVoltageHarmonic10:          _DICName[2649:2672],
+	DICPhaseCVoltageHarmonic11:          _DICName[2672:2695],
+	DICPhaseCVoltageHarmonic12:          _DICName[2695:2718],
+	DICPhaseCVoltageHarmonic13:          _DICName[2718:2741],
+	DICPhaseCVoltageHarmonic14:          _DICName[2741:2764],
+	DICPhaseCVoltageHarmonic15:          _DICName[2764:2787],
+	DICPhaseCVoltageHarmonic16:          _DICName[2787:2810],
+	DICPhaseCVoltageHarmonic17:          _DICName[2810:2833],
+	DICPhaseCVoltageHarmonic18:          _DICName[2833:2856],
+	DICPhaseCVoltageHarmonic19:          _DICName[2856:2879],
+	DICPhaseCVoltageHarmonic20:          _DICName[2879:2902],
+	DICPhaseCVoltageHarmonic21:          _DICName[2902:2925],
+	DICPhaseCVoltageHarmonic:            _DICName[2925:2946],
+	DICPhaseACurrentTHD:                 _DICName[2946:2962],
+	DICPhaseACurrentHarmonic2:           _DICName[2962:2984],
+	DICPhaseACurrentHarmonic3:           _DICName[2984:3006],
+	DICPhaseACurrentHarmonic4:           _DICName[3006:3028],
+	DICPhaseACurrentHarmonic5:           _DICName[3028:3050],
+	DICPhaseACurrentHarmonic6:           _DICName[3050:3072],
+	DICPhaseACurrentHarmonic7:           _DICName[3072:3094],
+	DICPhaseACurrentHarmonic8:           _DICName[3094:3116],
+	DICPhaseACurrentHarmonic9:           _DICName[3116:3138],
+	DICPhaseACurrentHarmonic10:          _DICName[3138:3161],
+	DICPhaseACurrentHarmonic11:          _DICName[3161:3184],
+	DICPhaseACurrentHarmonic12:          _DICName[3184:3207],
+	DICPhaseACurrentHarmonic13:          _DICName[3207:3230],
+	DICPhaseACurrentHarmonic14:          _DICName[3230:3253],
+	DICPhaseACurrentHarmonic15:          _DICName[3253:3276],
+	DICPhaseACurrentHarmonic16:          _DICName[3276:3299],
+	DICPhaseACurrentHarmonic17:          _DICName[3299:3322],
+	DICPhaseACurrentHarmonic18:          _DICName[3322:3345],
+	DICPhaseACurrentHarmonic19:          _DICName[3345:3368],
+	DICPhaseACurrentHarmonic20:          _DICName[3368:3391],
+	DICPhaseACurrentHarmonic21:          _DICName[3391:3414],
+	DICPhaseACurrentHarmonic:            _DICName[3414:3435],
+	DICPhaseBCurrentTHD:                 _DICName[3435:3451],
+	DICPhaseBCurrentHarmonic2:           _DICName[3451:3473],
+	DICPhaseBCurrentHarmonic3:           _DICName[3473:3495],
+	DICPhaseBCurrentHarmonic4:           _DICName[3495:3517],
+	DICPhaseBCurrentHarmonic5:           _DICName[3517:3539],
+	DICPhaseBCurrentHarmonic6:           _DICName[3539:3561],
+	DICPhaseBCurrentHarmonic7:           _DICName[3561:3583],
+	DICPhaseBCurrentHarmonic8:           _DICName[3583:3605],
+	DICPhaseBCurrentHarmonic9:           _DICName[3605:3627],
+	DICPhaseBCurrentHarmonic10:          _DICName[3627:3650],
+	DICPhaseBCurrentHarmonic11:          _DICName[3650:3673],
+	DICPhaseBCurrentHarmonic12:          _DICName[3673:3696],
+	DICPhaseBCurrentHarmonic13:          _DICName[3696:3719],
+	DICPhaseBCurrentHarmonic14:          _DICName[3719:3742],
+	DICPhaseBCurrentHarmonic15:          _DICName[3742:3765],
+	DICPhaseBCurrentHarmonic16:          _DICName[3765:3788],
+	DICPhaseBCurrentHarmonic17:          _DICName[3788:3811],
+	DICPhaseBCurrentHarmonic18:          _DICName[3811:3834],
+	DICPhaseBCurrentHarmonic19:          _DICName[3834:3857],
+	DICPhaseBCurrentHarmonic20:          _DICName[3857:3880],
+	DICPhaseBCurrentHarmonic21:          _DICName[3880:3903],
+	DICPhaseBCurrentHarmonic:            _DICName[3903:3924],
+	DICPhaseCCurrentTHD:                 _DICName[3924:3940],
+	DICPhaseCCurrentHarmonic2:           _DICName[3940:3962],
+	DICPhaseCCurrentHarmonic3:           _DICName[3962:3984],
+	DICPhaseCCurrentHarmonic4:           _DICName[3984:4006],
+	DICPhaseCCurrentHarmonic5:           _DICName[4006:4028],
+	DICPhaseCCurrentHarmonic6:           _DICName[4028:4050],
+	DICPhaseCCurrentHarmonic7:           _DICName[4050:4072],
+	DICPhaseCCurrentHarmonic8:           _DICName[4072:4094],
+	DICPhaseCCurrentHarmonic9:           _DICName[4094:4116],
+	DICPhaseCCurrentHarmonic10:          _DICName[4116:4139],
+	DICPhaseCCurrentHarmonic11:          _DICName[4139:4162],
+	DICPhaseCCurrentHarmonic12:          _DICName[4162:4185],
+	DICPhaseCCurrentHarmonic13:          _DICName[4185:4208],
+	DICPhaseCCurrentHarmonic14:          _DICName[4208:4231],
+	DICPhaseCCurrentHarmonic15:          _DICName[4231:4254],
+	DICPhaseCCurrentHarmonic16:          _DICName[4254:4277],
+	DICPhaseCCurrentHarmonic17:          _DICName[4277:4300],
+	DICPhaseCCurrentHarmonic18:          _DICName[4300:4323],
+	DICPhaseCCurrentHarmonic19:          _DICName[4323:4346],
+	DICPhaseCCurrentHarmonic20:          _DICName[4346:4369],
+	DICPhaseCCurrentHarmonic21:          _DICName[4369:4392],
+	DICPhaseCCurrentHarmonic:            _DICName[4392:4413],
+	DICABLineVoltage:                    _DICName[4413:4426],
+	DICBCLineVoltage:                    _DICName[4426:4439],
+	DICCALineVoltage:                    _DICName[4439:4452],
+	DICLineVoltage:                      _DICName[4452:4463],
+	DICNeutralCurrent:                   _DICName[4463:4477],
+	DICFrequency:                        _DICName[4477:4486],
+	DICAverageActivePower:               _DICName[4486:4504],
+	DICActiveDemand:                     _DICName[4504:4516],
+	DICReactiveDemand:                   _DICName[4516:4530],
+	DICApparentDemand:                   _DICName[4530:4544],
+	DICTemperature:                      _DICName[4544:4555],
+	DICClockBatteryVoltage:              _DICName[4555:4574],
+	DICReadingBatteryVoltage:            _DICName[4574:4595],
+	DICBatteryRunTime:                   _DICName[4595:4609],
+	DICCurrentTariffPrice:               _DICName[4609:4627],
+	DICTotalOverCurrentCount:            _DICName[4627:4648],
+	DICTotalPowerDownCount:              _DICName[4648:4667],
+	DICPowerDownRecord:                  _DICName[4667:4682],
+	DICTotalProgramCount:                _DICName[4682:4699],
+	DICProgramRecord:                    _DICName[4699:4712],
+	DICTotalMeterResetCount:             _DICName[4712:4732],
+	DICMeterResetRecord:                 _DICName[4732:4748],
+	DICTotalDemandResetCount:            _DICName[4748:4769],
+	DICDemandResetRecord:                _DICName[4769:4786],
+	DICTotalEventResetCount:             _DICName[4786:4806],
+	DICEventResetRecord:                 _DICName[4806:4822],
+	DICTotalClockAdjustCount:            _DICName[4822:4843],
+	DICClockAdjustRecord:                _DICName[4843:4860],
+	DICTotalMeterCoverOpenCount:         _DICName[4860:4884],
+	DICMeterCoverOpenRecord:             _DICName[4884:4904],
+	DICTotalTerminalCoverOpenCount:      _DICName[4904:4931],
+	DICTerminalCoverOpenRecord:          _DICName[4931:4954],
+	DICLastPurchaseTime:                 _DICName[4954:4970],
+	DICLastPurchaseCount:                _DICName[4970:4987],
+	DICLastPurchaseAmount:               _DICName[4987:5005],
+	DICLastPurchaseBalanceBefore:        _DICName[5005:5030],
+	DICLastPurchaseBalanceAfter:         _DICName[5030:5054],
+	DICLastTotalPurchaseAmount:          _DICName[5054:5077],
+	DICDateTime:                         _DICName[5077:5085],
+	DICTime:                             _DICName[5085:5089],
+	DICDemandPeriod:                     _DICName[5089:5101],
+	DICSlidingTime:                      _DICName[5101:5112],
+	DICTimeZoneSwitchTime:               _DICName[5112:5130],
+	DICDailyTableSwitchTime:             _DICName[5130:5150],
+	DICTariffSwitchTime:                 _DICName[5150:5166],
+	DICStepSwitchTime:                   _DICName[5166:5180],
+	DICTimeZoneCount:                    _DICName[5180:5193],
+	DICDailyTableCount:                  _DICName[5193:5208],
+	DICPeriodCount:                      _DICName[5208:5219],
+	DICTariffCount:                      _DICName[5219:5230],
+	DICHolidayCount:                     _DICName[5230:5242],
+	DICMeterNumber:                      _DICName[5242:5253],
+	DICAssetManagementCode:              _DICName[5253:5272],
+	DICActiveConstant:                   _DICName[5272:5286],
+	DICReactiveConstant:                 _DICName[5286:5302],
+	DICUserNumber:                       _DICName[5302:5312],
+	DICRunningStatusWord1:               _DICName[5312:5330],
+	DICRunningStatusWord2:               _DICName[5330:5348],
+	DICRunningStatusWord3:               _DICName[5348:5366],
+	DICRunningStatusWord4:               _DICName[5366:5384],
+	DICRunningStatusWord5:               _DICName[5384:5402],
+	DICRunningStatusWord6:               _DICName[5402:5420],
+	DICRunningStatusWord7:               _DICName[5420:5438],
+	DICRunningStatusWord:                _DICName[5438:5455],
+	DICAlarmAmount1Limit:                _DICName[5455:5472],
+	DICAlarmAmount2Limit:                _DICName[5472:5489],
+	DICOverdraftAmountLimit:             _DICName[5489:5509],
+	DICHoardingAmountLimit:              _DICName[5509:5528],
+	DICCloseAllowedAmountLimit:          _DICName[5528:5551],
+	DICActiveReportStatusWord:           _DICName[5551:5573],
+	DICFirstTimeZoneTable:               _DICName[5573:5591],
+	DICFirstDailyTable1:                 _DICName[5591:5607],
+	DICSecondTimeZoneTable:              _DICName[5607:5626],
+	DICSecondDailyTable1:                _DICName[5626:5643],
+	DICHoliday1:                         _DICName[5643:5651],
+	DICPositiveTotalReactiveEnergy:      _DICName[5651:5678],
+	DICPositiveReactiveEnergyRate1:      _DICName[5678:5705],
+	DICPositiveReactiveEnergyRate2:      _DICName[5705:5732],
+	DICPositiveReactiveEnergyRate3:      _DICName[5732:5759],
+	DICPositiveReactiveEnergyRate4:      _DICName[5759:5786],
+	DICPositiveReactiveEnergy:           _DICName[5786:5808],
+	DICNegativeTotalReactiveEnergy:      _DICName[5808:5835],
+	DICNegativeReactiveEnergyRate1:      _DICName[5835:5862],
+	DICNegativeReactiveEnergyRate2:      _DICName[5862:5889],
+	DICNegativeReactiveEnergyRate3:      _DICName[5889:5916],
+	DICNegativeReactiveEnergyRate4:      _DICName[5916:5943],
+	DICNegativeReactiveEnergy:           _DICName[5943:5965],
+	DICPositiveReactiveMaxDemand:        _DICName[5965:5990],
+	DICNegativeReactiveMaxDemand:        _DICName[5990:6015],
+	DICPositiveActiveMaxDemandTime:      _DICName[6015:6042],
+	DICPositiveActiveMaxDemandRate1Time: _DICName[6042:6074],
+	DICPositiveActiveMaxDemandRate2Time: _DICName[6074:6106],
+	DICPositiveActiveMaxDemandRate3Time: _DICName[6106:6138],
+	DICPositiveActiveMaxDemandRate4Time: _DICName[6138:6170],
+	DICPositiveActiveMaxDemandTimeBlock: _DICName[6170:6202],
+	DICNegativeActiveMaxDemandTime:      _DICName[6202:6229],
+	DICPositiveReactiveMaxDemandTime:    _DICName[6229:6258],
+	DICNegativeReactiveMaxDemandTime:    _DICName[6258:6287],
+	DICLastProgramTime:                  _DICName[6287:6302],
+	DICLastDemandResetTime:              _DICName[6302:6321],
+	DICTotalPhaseBreakCount:             _DICName[6321:6341],
+	DICPhaseABreakCount:                 _DICName[6341:6357],
+	DICPhaseBBreakCount:                 _DICName[6357:6373],
+	DICPhaseCBreakCount:                 _DICName[6373:6389],
+	DICPhaseBreakCount:                  _DICName[6389:6404],
+	DICTotalPhaseBreakTime:              _DICName[6404:6423],
+	DICPhaseABreakTime:                  _DICName[6423:6438],
+	DICPhaseBBreakTime:                  _DICName[6438:6453],
+	DICPhaseCBreakTime:                  _DICName[6453:6468],
+	DICPhaseBreakTime:                   _DICName[6468:6482],
+	DICTotalPhaseLastBreakStartTime:     _DICName[6482:6510],
+	DICPhaseALastBreakStartTime:         _DICName[6510:6534],
+	DICPhaseBLastBreakStartTime:         _DICName[6534:6558],
+	DICPhaseCLastBreakStartTime:         _DICName[6558:6582],
+	DICPhaseLastBreakStartTime:          _DICName[6582:6605],
+	DICTotalPhaseLastBreakEndTime:       _DICName[6605:6631],
+	DICPhaseALastBreakEndTime:           _DICName[6631:6653],
+	DICPhaseBLastBreakEndTime:           _DICName[6653:6675],
+	DICPhaseCLastBreakEndTime:           _DICName[6675:6697],
+	DICPhaseLastBreakEndTime:            _DICName[6697:6718],
+	DICMeterStatusWord:                  _DICName[6718:6733],
+	DICGridStatusWord:                   _DICName[6733:6747],
 }
 
 // Name is the attribute of DIC.
@@ -351,59 +1312,337 @@ func (x DIC) Name() string {
 }
 
 var _DICMapOld = map[DIC]uint16{
-	DICTotalActiveEnergy:             65535,
-	DICPositiveTotalActiveEnergy:     65535,
-	DICNegativeTotalActiveEnergy:     65535,
-	DICTotalReactiveEnergy1:          65535,
-	DICTotalReactiveEnergy2:          65535,
-	DICFirstQuadrantReactiveEnergy:   65535,
-	DICSecondQuadrantReactiveEnergy:  65535,
-	DICThirdQuadrantReactiveEnergy:   65535,
-	DICFourthQuadrantReactiveEnergy:  65535,
-	DICPositiveTotalApparentEnergy:   65535,
-	DICNegativeTotalApparentEnergy:   65535,
-	DICAssociatedTotalElectricEnergy: 65535,
-	DICPhaseAVoltage:                 46609,
-	DICPhaseBVoltage:                 46610,
-	DICPhaseCVoltage:                 46611,
-	DICVoltage:                       65535,
-	DICPhaseACurrent:                 46625,
-	DICPhaseBCurrent:                 46626,
-	DICPhaseCCurrent:                 46627,
-	DICCurrent:                       65535,
-	DICTotalActivePower:              46640,
-	DICPhaseAActivePower:             46641,
-	DICPhaseBActivePower:             46642,
-	DICPhaseCActivePower:             46643,
-	DICActivePower:                   65535,
-	DICTotalReactivePower:            46656,
-	DICPhaseAReactivePower:           46657,
-	DICPhaseBReactivePower:           46658,
-	DICPhaseCReactivePower:           46659,
-	DICReactivePower:                 65535,
-	DICTotalApparentPower:            46688,
-	DICPhaseAApparentPower:           46689,
-	DICPhaseBApparentPower:           46690,
-	DICPhaseCApparentPower:           46691,
-	DICApparentPower:                 65535,
-	DICTotalPowerFactor:              65535,
-	DICPhaseAPowerFactor:             65535,
-	DICPhaseBPowerFactor:             65535,
-	DICPhaseCPowerFactor:             65535,
-	DICPowerFactor:                   65535,
-	DICABLineVoltage:                 46737,
-	DICBCLineVoltage:                 46738,
-	DICCALineVoltage:                 46739,
-	DICLineVoltage:                   65535,
-	DICFrequency:                     65535,
-	DICTotalOverCurrentCount:         65535,
-	DICTotalMeterResetCount:          65535,
-	DICMeterResetRecord:              65535,
-	DICDateTime:                      65535,
-	DICTime:                          65535,
-	DICAssetManagementCode:           65535,
-	DICActiveConstant:                65535,
-	DICReactiveConstant:              65535,
+	DICTotalActiveEnergy:                65535,
+	DICPositiveTotalActiveEnergy:        36880,
+	DICPositiveActiveEnergyRate1:        36881,
+	DICPositiveActiveEnergyRate2:        36882,
+	DICPositiveActiveEnergyRate3:        36883,
+	DICPositiveActiveEnergyRate4:        36884,
+	DICPositiveActiveEnergy:             36895,
+	DICNegativeTotalActiveEnergy:        36896,
+	DICNegativeActiveEnergyRate1:        36897,
+	DICNegativeActiveEnergyRate2:        36898,
+	DICNegativeActiveEnergyRate3:        36899,
+	DICNegativeActiveEnergyRate4:        36900,
+	DICNegativeActiveEnergy:             36911,
+	DICTotalReactiveEnergy1:             65535,
+	DICReactiveEnergy1Rate1:             65535,
+	DICReactiveEnergy1Rate2:             65535,
+	DICReactiveEnergy1Rate3:             65535,
+	DICReactiveEnergy1Rate4:             65535,
+	DICReactiveEnergy1:                  65535,
+	DICTotalReactiveEnergy2:             65535,
+	DICReactiveEnergy2Rate1:             65535,
+	DICReactiveEnergy2Rate2:             65535,
+	DICReactiveEnergy2Rate3:             65535,
+	DICReactiveEnergy2Rate4:             65535,
+	DICReactiveEnergy2:                  65535,
+	DICFirstQuadrantReactiveEnergy:      37168,
+	DICSecondQuadrantReactiveEnergy:     37200,
+	DICThirdQuadrantReactiveEnergy:      37216,
+	DICFourthQuadrantReactiveEnergy:     37184,
+	DICPositiveTotalApparentEnergy:      65535,
+	DICNegativeTotalApparentEnergy:      65535,
+	DICAssociatedTotalElectricEnergy:    65535,
+	DICRemainingEnergy:                  65535,
+	DICOverdraftEnergy:                  65535,
+	DICRemainingAmount:                  65535,
+	DICOverdraftAmount:                  65535,
+	DICPositiveActiveMaxDemand:          40976,
+	DICPositiveActiveMaxDemandRate1:     40977,
+	DICPositiveActiveMaxDemandRate2:     40978,
+	DICPositiveActiveMaxDemandRate3:     40979,
+	DICPositiveActiveMaxDemandRate4:     40980,
+	DICPositiveActiveMaxDemandBlock:     40991,
+	DICNegativeActiveMaxDemand:          40992,
+	DICReactiveMaxDemand1:               65535,
+	DICReactiveMaxDemand2:               65535,
+	DICPhaseAVoltage:                    46609,
+	DICPhaseBVoltage:                    46610,
+	DICPhaseCVoltage:                    46611,
+	DICVoltage:                          46623,
+	DICPhaseACurrent:                    46625,
+	DICPhaseBCurrent:                    46626,
+	DICPhaseCCurrent:                    46627,
+	DICCurrent:                          46639,
+	DICTotalActivePower:                 46640,
+	DICPhaseAActivePower:                46641,
+	DICPhaseBActivePower:                46642,
+	DICPhaseCActivePower:                46643,
+	DICActivePower:                      46655,
+	DICTotalReactivePower:               46656,
+	DICPhaseAReactivePower:              46657,
+	DICPhaseBReactivePower:              46658,
+	DICPhaseCReactivePower:              46659,
+	DICReactivePower:                    46671,
+	DICTotalApparentPower:               46688,
+	DICPhaseAApparentPower:              46689,
+	DICPhaseBApparentPower:              46690,
+	DICPhaseCApparentPower:              46691,
+	DICApparentPower:                    65535,
+	DICTotalPowerFactor:                 46672,
+	DICPhaseAPowerFactor:                46673,
+	DICPhaseBPowerFactor:                46674,
+	DICPhaseCPowerFactor:                46675,
+	DICPowerFactor:                      46687,
+	DICPhaseAAngle:                      65535,
+	DICPhaseBAngle:                      65535,
+	DICPhaseCAngle:                      65535,
+	DICPhaseAngle:                       65535,
+	DICPhaseAVoltageTHD:                 65535,
+	DICPhaseAVoltageHarmonic2:           65535,
+	DICPhaseAVoltageHarmonic3:           65535,
+	DICPhaseAVoltageHarmonic4:           65535,
+	DICPhaseAVoltageHarmonic5:           65535,
+	DICPhaseAVoltageHarmonic6:           65535,
+	DICPhaseAVoltageHarmonic7:           65535,
+	DICPhaseAVoltageHarmonic8:           65535,
+	DICPhaseAVoltageHarmonic9:           65535,
+	DICPhaseAVoltageHarmonic10:          65535,
+	DICPhaseAVoltageHarmonic11:          65535,
+	DICPhaseAVoltageHarmonic12:          65535,
+	DICPhaseAVoltageHarmonic13:          65535,
+	DICPhaseAVoltageHarmonic14:          65535,
+	DICPhaseAVoltageHarmonic15:          65535,
+	DICPhaseAVoltageHarmonic16:          65535,
+	DICPhaseAVoltageHarmonic17:          65535,
+	DICPhaseAVoltageHarmonic18:          65535,
+	DICPhaseAVoltageHarmonic19:          65535,
+	DICPhaseAVoltageHarmonic20:          65535,
+	DICPhaseAVoltageHarmonic21:          65535,
+	DICPhaseAVoltageHarmonic:            65535,
+	DICPhaseBVoltageTHD:                 65535,
+	DICPhaseBVoltageHarmonic2:           65535,
+	DICPhaseBVoltageHarmonic3:           65535,
+	DICPhaseBVoltageHarmonic4:           65535,
+	DICPhaseBVoltageHarmonic5:           65535,
+	DICPhaseBVoltageHarmonic6:           65535,
+	DICPhaseBVoltageHarmonic7:           65535,
+	DICPhaseBVoltageHarmonic8:           65535,
+	DICPhaseBVoltageHarmonic9:           65535,
+	DICPhaseBVoltageHarmonic10:          65535,
+	DICPhaseBVoltageHarmonic11:          65535,
+	DICPhaseBVoltageHarmonic12:          65535,
+	DICPhaseBVoltageHarmonic13:          65535,
+	DICPhaseBVoltageHarmonic14:          65535,
+	DICPhaseBVoltageHarmonic15:          65535,
+	DICPhaseBVoltageHarmonic16:          65535,
+	DICPhaseBVoltageHarmonic17:          65535,
+	DICPhaseBVoltageHarmonic18:          65535,
+	DICPhaseBVoltageHarmonic19:          65535,
+	DICPhaseBVoltageHarmonic20:          65535,
+	DICPhaseBVoltageHarmonic21:          65535,
+	DICPhaseBVoltageHarmonic:            65535,
+	DICPhaseCVoltageTHD:                 65535,
+	DICPhaseCVoltageHarmonic2:           65535,
+	DICPhaseCVoltageHarmonic3:           65535,
+	DICPhaseCVoltageHarmonic4:           65535,
+	DICPhaseCVoltageHarmonic5:           65535,
+	DICPhaseCVoltageHarmonic6:           65535,
+	DICPhaseCVoltageHarmonic7:           65535,
+	DICPhaseCVoltageHarmonic8:           65535,
+	DICPhaseCVoltageHarmonic9:           65535,
+	DICPhaseCVoltageHarmonic10:          65535,
+	DICPhaseCVoltageHarmonic11:          65535,
+	DICPhaseCVoltageHarmonic12:          65535,
+	DICPhaseCVoltageHarmonic13:          65535,
+	DICPhaseCVoltageHarmonic14:          65535,
+	DICPhaseCVoltageHarmonic15:          65535,
+	DICPhaseCVoltageHarmonic16:          65535,
+	DICPhaseCVoltageHarmonic17:          65535,
+	DICPhaseCVoltageHarmonic18:          65535,
+	DICPhaseCVoltageHarmonic19:          65535,
+	DICPhaseCVoltageHarmonic20:          65535,
+	DICPhaseCVoltageHarmonic21:          65535,
+	DICPhaseCVoltageHarmonic:            65535,
+	DICPhaseACurrentTHD:                 65535,
+	DICPhaseACurrentHarmonic2:           65535,
+	DICPhaseACurrentHarmonic3:           65535,
+	DICPhaseACurrentHarmonic4:           65535,
+	DICPhaseACurrentHarmonic5:           65535,
+	DICPhaseACurrentHarmonic6:           65535,
+	DICPhaseACurrentHarmonic7:           65535,
+	DICPhaseACurrentHarmonic8:           65535,
+	DICPhaseACurrentHarmonic9:           65535,
+	DICPhaseACurrentHarmonic10:          65535,
+	DICPhaseACurrentHarmonic11:          65535,
+	DICPhaseACurrentHarmonic12:          65535,
+	DICPhaseACurrentHarmonic13:          65535,
+	DICPhaseACurrentHarmonic14:          65535,
+	DICPhaseACurrentHarmonic15:          65535,
+	DICPhaseACurrentHarmonic16:          65535,
+	DICPhaseACurrentHarmonic17:          65535,
+	DICPhaseACurrentHarmonic18:          65535,
+	DICPhaseACurrentHarmonic19:          65535,
+	DICPhaseACurrentHarmonic20:          65535,
+	DICPhaseACurrentHarmonic21:          65535,
+	DICPhaseACurrentHarmonic:            65535,
+	DICPhaseBCurrentTHD:                 65535,
+	DICPhaseBCurrentHarmonic2:           65535,
+	DICPhaseBCurrentHarmonic3:           65535,
+	DICPhaseBCurrentHarmonic4:           65535,
+	DICPhaseBCurrentHarmonic5:           65535,
+	DICPhaseBCurrentHarmonic6:           65535,
+	DICPhaseBCurrentHarmonic7:           65535,
+	DICPhaseBCurrentHarmonic8:           65535,
+	DICPhaseBCurrentHarmonic9:           65535,
+	DICPhaseBCurrentHarmonic10:          65535,
+	DICPhaseBCurrentHarmonic11:          65535,
+	DICPhaseBCurrentHarmonic12:          65535,
+	DICPhaseBCurrentHarmonic13:          65535,
+	DICPhaseBCurrentHarmonic14:          65535,
+	DICPhaseBCurrentHarmonic15:          65535,
+	DICPhaseBCurrentHarmonic16:          65535,
+	DICPhaseBCurrentHarmonic17:          65535,
+	DICPhaseBCurrentHarmonic18:          65535,
+	DICPhaseBCurrentHarmonic19:          65535,
+	DICPhaseBCurrentHarmonic20:          65535,
+	DICPhaseBCurrentHarmonic21:          65535,
+	DICPhaseBCurrentHarmonic:            65535,
+	DICPhaseCCurrentTHD:                 65535,
+	DICPhaseCCurrentHarmonic2:           65535,
+	DICPhaseCCurrentHarmonic3:           65535,
+	DICPhaseCCurrentHarmonic4:           65535,
+	DICPhaseCCurrentHarmonic5:           65535,
+	DICPhaseCCurrentHarmonic6:           65535,
+	DICPhaseCCurrentHarmonic7:           65535,
+	DICPhaseCCurrentHarmonic8:           65535,
+	DICPhaseCCurrentHarmonic9:           65535,
+	DICPhaseCCurrentHarmonic10:          65535,
+	DICPhaseCCurrentHarmonic11:          65535,
+	DICPhaseCCurrentHarmonic12:          65535,
+	DICPhaseCCurrentHarmonic13:          65535,
+	DICPhaseCCurrentHarmonic14:          65535,
+	DICPhaseCCurrentHarmonic15:          65535,
+	DICPhaseCCurrentHarmonic16:          65535,
+	DICPhaseCCurrentHarmonic17:          65535,
+	DICPhaseCCurrentHarmonic18:          65535,
+	DICPhaseCCurrentHarmonic19:          65535,
+	DICPhaseCCurrentHarmonic20:          65535,
+	DICPhaseCCurrentHarmonic21:          65535,
+	DICPhaseCCurrentHarmonic:            65535,
+	DICABLineVoltage:                    46737,
+	DICBCLineVoltage:                    46738,
+	DICCALineVoltage:                    46739,
+	DICLineVoltage:                      65535,
+	DICNeutralCurrent:                   65535,
+	DICFrequency:                        65535,
+	DICAverageActivePower:               65535,
+	DICActiveDemand:                     65535,
+	DICReactiveDemand:                   65535,
+	DICApparentDemand:                   65535,
+	DICTemperature:                      65535,
+	DICClockBatteryVoltage:              65535,
+	DICReadingBatteryVoltage:            65535,
+	DICBatteryRunTime:                   45588,
+	DICCurrentTariffPrice:               65535,
+	DICTotalOverCurrentCount:            65535,
+	DICTotalPowerDownCount:              65535,
+	DICPowerDownRecord:                  65535,
+	DICTotalProgramCount:                45586,
+	DICProgramRecord:                    65535,
+	DICTotalMeterResetCount:             65535,
+	DICMeterResetRecord:                 65535,
+	DICTotalDemandResetCount:            45587,
+	DICDemandResetRecord:                65535,
+	DICTotalEventResetCount:             65535,
+	DICEventResetRecord:                 65535,
+	DICTotalClockAdjustCount:            65535,
+	DICClockAdjustRecord:                65535,
+	DICTotalMeterCoverOpenCount:         65535,
+	DICMeterCoverOpenRecord:             65535,
+	DICTotalTerminalCoverOpenCount:      65535,
+	DICTerminalCoverOpenRecord:          65535,
+	DICLastPurchaseTime:                 65535,
+	DICLastPurchaseCount:                65535,
+	DICLastPurchaseAmount:               65535,
+	DICLastPurchaseBalanceBefore:        65535,
+	DICLastPurchaseBalanceAfter:         65535,
+	DICLastTotalPurchaseAmount:          65535,
+	DICDateTime:                         49168,
+	DICTime:                             49169,
+	DICDemandPeriod:                     49425,
+	DICSlidingTime:                      49426,
+	DICTimeZoneSwitchTime:               65535,
+	DICDailyTableSwitchTime:             65535,
+	DICTariffSwitchTime:                 65535,
+	DICStepSwitchTime:                   65535,
+	DICTimeZoneCount:                    49936,
+	DICDailyTableCount:                  49937,
+	DICPeriodCount:                      49938,
+	DICTariffCount:                      49939,
+	DICHolidayCount:                     49940,
+	DICMeterNumber:                      49202,
+	DICAssetManagementCode:              65535,
+	DICActiveConstant:                   49200,
+	DICReactiveConstant:                 49201,
+	DICUserNumber:                       49203,
+	DICRunningStatusWord1:               65535,
+	DICRunningStatusWord2:               65535,
+	DICRunningStatusWord3:               65535,
+	DICRunningStatusWord4:               65535,
+	DICRunningStatusWord5:               65535,
+	DICRunningStatusWord6:               65535,
+	DICRunningStatusWord7:               65535,
+	DICRunningStatusWord:                65535,
+	DICAlarmAmount1Limit:                65535,
+	DICAlarmAmount2Limit:                65535,
+	DICOverdraftAmountLimit:             65535,
+	DICHoardingAmountLimit:              65535,
+	DICCloseAllowedAmountLimit:          65535,
+	DICActiveReportStatusWord:           65535,
+	DICFirstTimeZoneTable:               65535,
+	DICFirstDailyTable1:                 65535,
+	DICSecondTimeZoneTable:              65535,
+	DICSecondDailyTable1:                65535,
+	DICHoliday1:                         65535,
+	DICPositiveTotalReactiveEnergy:      37136,
+	DICPositiveReactiveEnergyRate1:      37137,
+	DICPositiveReactiveEnergyRate2:      37138,
+	DICPositiveReactiveEnergyRate3:      37139,
+	DICPositiveReactiveEnergyRate4:      37140,
+	DICPositiveReactiveEnergy:           37151,
+	DICNegativeTotalReactiveEnergy:      37152,
+	DICNegativeReactiveEnergyRate1:      37153,
+	DICNegativeReactiveEnergyRate2:      37154,
+	DICNegativeReactiveEnergyRate3:      37155,
+	DICNegativeReactiveEnergyRate4:      37156,
+	DICNegativeReactiveEnergy:           37167,
+	DICPositiveReactiveMaxDemand:        41232,
+	DICNegativeReactiveMaxDemand:        41248,
+	DICPositiveActiveMaxDemandTime:      45072,
+	DICPositiveActiveMaxDemandRate1Time: 45073,
+	DICPositiveActiveMaxDemandRate2Time: 45074,
+	DICPositiveActiveMaxDemandRate3Time: 45075,
+	DICPositiveActiveMaxDemandRate4Time: 45076,
+	DICPositiveActiveMaxDemandTimeBlock: 45087,
+	DICNegativeActiveMaxDemandTime:      45088,
+	DICPositiveReactiveMaxDemandTime:    45328,
+	DICNegativeReactiveMaxDemandTime:    45344,
+	DICLastProgramTime:                  45584,
+	DICLastDemandResetTime:              45585,
+	DICTotalPhaseBreakCount:             45840,
+	DICPhaseABreakCount:                 45841,
+	DICPhaseBBreakCount:                 45842,
+	DICPhaseCBreakCount:                 45843,
+	DICPhaseBreakCount:                  45855,
+	DICTotalPhaseBreakTime:              45856,
+	DICPhaseABreakTime:                  45857,
+	DICPhaseBBreakTime:                  45858,
+	DICPhaseCBreakTime:                  45859,
+	DICPhaseBreakTime:                   45871,
+	DICTotalPhaseLastBreakStartTime:     45872,
+	DICPhaseALastBreakStartTime:         45873,
+	DICPhaseBLastBreakStartTime:         45874,
+	DICPhaseCLastBreakStartTime:         45875,
+	DICPhaseLastBreakStartTime:          45887,
+	DICTotalPhaseLastBreakEndTime:       45888,
+	DICPhaseALastBreakEndTime:           45889,
+	DICPhaseBLastBreakEndTime:           45890,
+	DICPhaseCLastBreakEndTime:           45891,
+	DICPhaseLastBreakEndTime:            45903,
+	DICMeterStatusWord:                  49184,
+	DICGridStatusWord:                   49185,
 }
 
 // Old is the attribute of DIC.
@@ -415,59 +1654,337 @@ func (x DIC) Old() uint16 {
 }
 
 var _DICMapOldFormat = map[DIC]string{
-	DICTotalActiveEnergy:             "",
-	DICPositiveTotalActiveEnergy:     "",
-	DICNegativeTotalActiveEnergy:     "",
-	DICTotalReactiveEnergy1:          "",
-	DICTotalReactiveEnergy2:          "",
-	DICFirstQuadrantReactiveEnergy:   "",
-	DICSecondQuadrantReactiveEnergy:  "",
-	DICThirdQuadrantReactiveEnergy:   "",
-	DICFourthQuadrantReactiveEnergy:  "",
-	DICPositiveTotalApparentEnergy:   "",
-	DICNegativeTotalApparentEnergy:   "",
-	DICAssociatedTotalElectricEnergy: "",
-	DICPhaseAVoltage:                 "XXX",
-	DICPhaseBVoltage:                 "XXX",
-	DICPhaseCVoltage:                 "XXX",
-	DICVoltage:                       "",
-	DICPhaseACurrent:                 "XX.XX",
-	DICPhaseBCurrent:                 "XX.XX",
-	DICPhaseCCurrent:                 "XX.XX",
-	DICCurrent:                       "",
-	DICTotalActivePower:              "XX.XXXX",
-	DICPhaseAActivePower:             "XX.XXXX",
-	DICPhaseBActivePower:             "XX.XXXX",
-	DICPhaseCActivePower:             "XX.XXXX",
-	DICActivePower:                   "",
-	DICTotalReactivePower:            "",
-	DICPhaseAReactivePower:           "",
-	DICPhaseBReactivePower:           "",
-	DICPhaseCReactivePower:           "",
-	DICReactivePower:                 "",
-	DICTotalApparentPower:            "",
-	DICPhaseAApparentPower:           "",
-	DICPhaseBApparentPower:           "",
-	DICPhaseCApparentPower:           "",
-	DICApparentPower:                 "",
-	DICTotalPowerFactor:              "",
-	DICPhaseAPowerFactor:             "",
-	DICPhaseBPowerFactor:             "",
-	DICPhaseCPowerFactor:             "",
-	DICPowerFactor:                   "",
-	DICABLineVoltage:                 "XXX",
-	DICBCLineVoltage:                 "XXX",
-	DICCALineVoltage:                 "XXX",
-	DICLineVoltage:                   "",
-	DICFrequency:                     "",
-	DICTotalOverCurrentCount:         "",
-	DICTotalMeterResetCount:          "",
-	DICMeterResetRecord:              "",
-	DICDateTime:                      "",
-	DICTime:                          "",
-	DICAssetManagementCode:           "",
-	DICActiveConstant:                "",
-	DICReactiveConstant:              "",
+	DICTotalActiveEnergy:                "",
+	DICPositiveTotalActiveEnergy:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate1:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate2:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate3:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate4:        "XXXXXX.XX",
+	DICPositiveActiveEnergy:             "XXXXXX.XX",
+	DICNegativeTotalActiveEnergy:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate1:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate2:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate3:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate4:        "XXXXXX.XX",
+	DICNegativeActiveEnergy:             "XXXXXX.XX",
+	DICTotalReactiveEnergy1:             "",
+	DICReactiveEnergy1Rate1:             "",
+	DICReactiveEnergy1Rate2:             "",
+	DICReactiveEnergy1Rate3:             "",
+	DICReactiveEnergy1Rate4:             "",
+	DICReactiveEnergy1:                  "",
+	DICTotalReactiveEnergy2:             "",
+	DICReactiveEnergy2Rate1:             "",
+	DICReactiveEnergy2Rate2:             "",
+	DICReactiveEnergy2Rate3:             "",
+	DICReactiveEnergy2Rate4:             "",
+	DICReactiveEnergy2:                  "",
+	DICFirstQuadrantReactiveEnergy:      "XXXXXX.XX",
+	DICSecondQuadrantReactiveEnergy:     "XXXXXX.XX",
+	DICThirdQuadrantReactiveEnergy:      "XXXXXX.XX",
+	DICFourthQuadrantReactiveEnergy:     "XXXXXX.XX",
+	DICPositiveTotalApparentEnergy:      "",
+	DICNegativeTotalApparentEnergy:      "",
+	DICAssociatedTotalElectricEnergy:    "",
+	DICRemainingEnergy:                  "",
+	DICOverdraftEnergy:                  "",
+	DICRemainingAmount:                  "",
+	DICOverdraftAmount:                  "",
+	DICPositiveActiveMaxDemand:          "XX.XXXX",
+	DICPositiveActiveMaxDemandRate1:     "XX.XXXX",
+	DICPositiveActiveMaxDemandRate2:     "XX.XXXX",
+	DICPositiveActiveMaxDemandRate3:     "XX.XXXX",
+	DICPositiveActiveMaxDemandRate4:     "XX.XXXX",
+	DICPositiveActiveMaxDemandBlock:     "XX.XXXX",
+	DICNegativeActiveMaxDemand:          "XX.XXXX",
+	DICReactiveMaxDemand1:               "",
+	DICReactiveMaxDemand2:               "",
+	DICPhaseAVoltage:                    "XXX",
+	DICPhaseBVoltage:                    "XXX",
+	DICPhaseCVoltage:                    "XXX",
+	DICVoltage:                          "XXX",
+	DICPhaseACurrent:                    "XX.XX",
+	DICPhaseBCurrent:                    "XX.XX",
+	DICPhaseCCurrent:                    "XX.XX",
+	DICCurrent:                          "XX.XX",
+	DICTotalActivePower:                 "XX.XXXX",
+	DICPhaseAActivePower:                "XX.XXXX",
+	DICPhaseBActivePower:                "XX.XXXX",
+	DICPhaseCActivePower:                "XX.XXXX",
+	DICActivePower:                      "XX.XXXX",
+	DICTotalReactivePower:               "XX.XX",
+	DICPhaseAReactivePower:              "XX.XX",
+	DICPhaseBReactivePower:              "XX.XX",
+	DICPhaseCReactivePower:              "XX.XX",
+	DICReactivePower:                    "XX.XX",
+	DICTotalApparentPower:               "",
+	DICPhaseAApparentPower:              "",
+	DICPhaseBApparentPower:              "",
+	DICPhaseCApparentPower:              "",
+	DICApparentPower:                    "",
+	DICTotalPowerFactor:                 "X.XXX",
+	DICPhaseAPowerFactor:                "X.XXX",
+	DICPhaseBPowerFactor:                "X.XXX",
+	DICPhaseCPowerFactor:                "X.XXX",
+	DICPowerFactor:                      "X.XXX",
+	DICPhaseAAngle:                      "",
+	DICPhaseBAngle:                      "",
+	DICPhaseCAngle:                      "",
+	DICPhaseAngle:                       "",
+	DICPhaseAVoltageTHD:                 "",
+	DICPhaseAVoltageHarmonic2:           "",
+	DICPhaseAVoltageHarmonic3:           "",
+	DICPhaseAVoltageHarmonic4:           "",
+	DICPhaseAVoltageHarmonic5:           "",
+	DICPhaseAVoltageHarmonic6:           "",
+	DICPhaseAVoltageHarmonic7:           "",
+	DICPhaseAVoltageHarmonic8:           "",
+	DICPhaseAVoltageHarmonic9:           "",
+	DICPhaseAVoltageHarmonic10:          "",
+	DICPhaseAVoltageHarmonic11:          "",
+	DICPhaseAVoltageHarmonic12:          "",
+	DICPhaseAVoltageHarmonic13:          "",
+	DICPhaseAVoltageHarmonic14:          "",
+	DICPhaseAVoltageHarmonic15:          "",
+	DICPhaseAVoltageHarmonic16:          "",
+	DICPhaseAVoltageHarmonic17:          "",
+	DICPhaseAVoltageHarmonic18:          "",
+	DICPhaseAVoltageHarmonic19:          "",
+	DICPhaseAVoltageHarmonic20:          "",
+	DICPhaseAVoltageHarmonic21:          "",
+	DICPhaseAVoltageHarmonic:            "",
+	DICPhaseBVoltageTHD:                 "",
+	DICPhaseBVoltageHarmonic2:           "",
+	DICPhaseBVoltageHarmonic3:           "",
+	DICPhaseBVoltageHarmonic4:           "",
+	DICPhaseBVoltageHarmonic5:           "",
+	DICPhaseBVoltageHarmonic6:           "",
+	DICPhaseBVoltageHarmonic7:           "",
+	DICPhaseBVoltageHarmonic8:           "",
+	DICPhaseBVoltageHarmonic9:           "",
+	DICPhaseBVoltageHarmonic10:          "",
+	DICPhaseBVoltageHarmonic11:          "",
+	DICPhaseBVoltageHarmonic12:          "",
+	DICPhaseBVoltageHarmonic13:          "",
+	DICPhaseBVoltageHarmonic14:          "",
+	DICPhaseBVoltageHarmonic15:          "",
+	DICPhaseBVoltageHarmonic16:          "",
+	DICPhaseBVoltageHarmonic17:          "",
+	DICPhaseBVoltageHarmonic18:          "",
+	DICPhaseBVoltageHarmonic19:          "",
+	DICPhaseBVoltageHarmonic20:          "",
+	DICPhaseBVoltageHarmonic21:          "",
+	DICPhaseBVoltageHarmonic:            "",
+	DICPhaseCVoltageTHD:                 "",
+	DICPhaseCVoltageHarmonic2:           "",
+	DICPhaseCVoltageHarmonic3:           "",
+	DICPhaseCVoltageHarmonic4:           "",
+	DICPhaseCVoltageHarmonic5:           "",
+	DICPhaseCVoltageHarmonic6:           "",
+	DICPhaseCVoltageHarmonic7:           "",
+	DICPhaseCVoltageHarmonic8:           "",
+	DICPhaseCVoltageHarmonic9:           "",
+	DICPhaseCVoltageHarmonic10:          "",
+	DICPhaseCVoltageHarmonic11:          "",
+	DICPhaseCVoltageHarmonic12:          "",
+	DICPhaseCVoltageHarmonic13:          "",
+	DICPhaseCVoltageHarmonic14:          "",
+	DICPhaseCVoltageHarmonic15:          "",
+	DICPhaseCVoltageHarmonic16:          "",
+	DICPhaseCVoltageHarmonic17:          "",
+	DICPhaseCVoltageHarmonic18:          "",
+	DICPhaseCVoltageHarmonic19:          "",
+	DICPhaseCVoltageHarmonic20:          "",
+	DICPhaseCVoltageHarmonic21:          "",
+	DICPhaseCVoltageHarmonic:            "",
+	DICPhaseACurrentTHD:                 "",
+	DICPhaseACurrentHarmonic2:           "",
+	DICPhaseACurrentHarmonic3:           "",
+	DICPhaseACurrentHarmonic4:           "",
+	DICPhaseACurrentHarmonic5:           "",
+	DICPhaseACurrentHarmonic6:           "",
+	DICPhaseACurrentHarmonic7:           "",
+	DICPhaseACurrentHarmonic8:           "",
+	DICPhaseACurrentHarmonic9:           "",
+	DICPhaseACurrentHarmonic10:          "",
+	DICPhaseACurrentHarmonic11:          "",
+	DICPhaseACurrentHarmonic12:          "",
+	DICPhaseACurrentHarmonic13:          "",
+	DICPhaseACurrentHarmonic14:          "",
+	DICPhaseACurrentHarmonic15:          "",
+	DICPhaseACurrentHarmonic16:          "",
+	DICPhaseACurrentHarmonic17:          "",
+	DICPhaseACurrentHarmonic18:          "",
+	DICPhaseACurrentHarmonic19:          "",
+	DICPhaseACurrentHarmonic20:          "",
+	DICPhaseACurrentHarmonic21:          "",
+	DICPhaseACurrentHarmonic:            "",
+	DICPhaseBCurrentTHD:                 "",
+	DICPhaseBCurrentHarmonic2:           "",
+	DICPhaseBCurrentHarmonic3:           "",
+	DICPhaseBCurrentHarmonic4:           "",
+	DICPhaseBCurrentHarmonic5:           "",
+	DICPhaseBCurrentHarmonic6:           "",
+	DICPhaseBCurrentHarmonic7:           "",
+	DICPhaseBCurrentHarmonic8:           "",
+	DICPhaseBCurrentHarmonic9:           "",
+	DICPhaseBCurrentHarmonic10:          "",
+	DICPhaseBCurrentHarmonic11:          "",
+	DICPhaseBCurrentHarmonic12:          "",
+	DICPhaseBCurrentHarmonic13:          "",
+	DICPhaseBCurrentHarmonic14:          "",
+	DICPhaseBCurrentHarmonic15:          "",
+	DICPhaseBCurrentHarmonic16:          "",
+	DICPhaseBCurrentHarmonic17:          "",
+	DICPhaseBCurrentHarmonic18:          "",
+	DICPhaseBCurrentHarmonic19:          "",
+	DICPhaseBCurrentHarmonic20:          "",
+	DICPhaseBCurrentHarmonic21:          "",
+	DICPhaseBCurrentHarmonic:            "",
+	DICPhaseCCurrentTHD:                 "",
+	DICPhaseCCurrentHarmonic2:           "",
+	DICPhaseCCurrentHarmonic3:           "",
+	DICPhaseCCurrentHarmonic4:           "",
+	DICPhaseCCurrentHarmonic5:           "",
+	DICPhaseCCurrentHarmonic6:           "",
+	DICPhaseCCurrentHarmonic7:           "",
+	DICPhaseCCurrentHarmonic8:           "",
+	DICPhaseCCurrentHarmonic9:           "",
+	DICPhaseCCurrentHarmonic10:          "",
+	DICPhaseCCurrentHarmonic11:          "",
+	DICPhaseCCurrentHarmonic12:          "",
+	DICPhaseCCurrentHarmonic13:          "",
+	DICPhaseCCurrentHarmonic14:          "",
+	DICPhaseCCurrentHarmonic15:          "",
+	DICPhaseCCurrentHarmonic16:          "",
+	DICPhaseCCurrentHarmonic17:          "",
+	DICPhaseCCurrentHarmonic18:          "",
+	DICPhaseCCurrentHarmonic19:          "",
+	DICPhaseCCurrentHarmonic20:          "",
+	DICPhaseCCurrentHarmonic21:          "",
+	DICPhaseCCurrentHarmonic:            "",
+	DICABLineVoltage:                    "XXX",
+	DICBCLineVoltage:                    "XXX",
+	DICCALineVoltage:                    "XXX",
+	DICLineVoltage:                      "",
+	DICNeutralCurrent:                   "",
+	DICFrequency:                        "",
+	DICAverageActivePower:               "",
+	DICActiveDemand:                     "",
+	DICReactiveDemand:                   "",
+	DICApparentDemand:                   "",
+	DICTemperature:                      "",
+	DICClockBatteryVoltage:              "",
+	DICReadingBatteryVoltage:            "",
+	DICBatteryRunTime:                   "NNNNNN",
+	DICCurrentTariffPrice:               "",
+	DICTotalOverCurrentCount:            "",
+	DICTotalPowerDownCount:              "",
+	DICPowerDownRecord:                  "",
+	DICTotalProgramCount:                "NNNN",
+	DICProgramRecord:                    "",
+	DICTotalMeterResetCount:             "",
+	DICMeterResetRecord:                 "",
+	DICTotalDemandResetCount:            "NNNN",
+	DICDemandResetRecord:                "",
+	DICTotalEventResetCount:             "",
+	DICEventResetRecord:                 "",
+	DICTotalClockAdjustCount:            "",
+	DICClockAdjustRecord:                "",
+	DICTotalMeterCoverOpenCount:         "",
+	DICMeterCoverOpenRecord:             "",
+	DICTotalTerminalCoverOpenCount:      "",
+	DICTerminalCoverOpenRecord:          "",
+	DICLastPurchaseTime:                 "",
+	DICLastPurchaseCount:                "",
+	DICLastPurchaseAmount:               "",
+	DICLastPurchaseBalanceBefore:        "",
+	DICLastPurchaseBalanceAfter:         "",
+	DICLastTotalPurchaseAmount:          "",
+	DICDateTime:                         "YYMMDDWW",
+	DICTime:                             "hhmmss",
+	DICDemandPeriod:                     "NN",
+	DICSlidingTime:                      "NN",
+	DICTimeZoneSwitchTime:               "",
+	DICDailyTableSwitchTime:             "",
+	DICTariffSwitchTime:                 "",
+	DICStepSwitchTime:                   "",
+	DICTimeZoneCount:                    "NN",
+	DICDailyTableCount:                  "NN",
+	DICPeriodCount:                      "NN",
+	DICTariffCount:                      "NN",
+	DICHolidayCount:                     "NN",
+	DICMeterNumber:                      "NNNNNNNNNNNN",
+	DICAssetManagementCode:              "",
+	DICActiveConstant:                   "NNNNNN",
+	DICReactiveConstant:                 "NNNNNN",
+	DICUserNumber:                       "NNNNNNNNNNNN",
+	DICRunningStatusWord1:               "",
+	DICRunningStatusWord2:               "",
+	DICRunningStatusWord3:               "",
+	DICRunningStatusWord4:               "",
+	DICRunningStatusWord5:               "",
+	DICRunningStatusWord6:               "",
+	DICRunningStatusWord7:               "",
+	DICRunningStatusWord:                "",
+	DICAlarmAmount1Limit:                "",
+	DICAlarmAmount2Limit:                "",
+	DICOverdraftAmountLimit:             "",
+	DICHoardingAmountLimit:              "",
+	DICCloseAllowedAmountLimit:          "",
+	DICActiveReportStatusWord:           "",
+	DICFirstTimeZoneTable:               "",
+	DICFirstDailyTable1:                 "",
+	DICSecondTimeZoneTable:              "",
+	DICSecondDailyTable1:                "",
+	DICHoliday1:                         "",
+	DICPositiveTotalReactiveEnergy:      "XXXXXX.XX",
+	DICPositiveReactiveEnergyRate1:      "XXXXXX.XX",
+	DICPositiveReactiveEnergyRate2:      "XXXXXX.XX",
+	DICPositiveReactiveEnergyRate3:      "XXXXXX.XX",
+	DICPositiveReactiveEnergyRate4:      "XXXXXX.XX",
+	DICPositiveReactiveEnergy:           "XXXXXX.XX",
+	DICNegativeTotalReactiveEnergy:      "XXXXXX.XX",
+	DICNegativeReactiveEnergyRate1:      "XXXXXX.XX",
+	DICNegativeReactiveEnergyRate2:      "XXXXXX.XX",
+	DICNegativeReactiveEnergyRate3:      "XXXXXX.XX",
+	DICNegativeReactiveEnergyRate4:      "XXXXXX.XX",
+	DICNegativeReactiveEnergy:           "XXXXXX.XX",
+	DICPositiveReactiveMaxDemand:        "XX.XXXX",
+	DICNegativeReactiveMaxDemand:        "XX.XXXX",
+	DICPositiveActiveMaxDemandTime:      "MMDDhhmm",
+	DICPositiveActiveMaxDemandRate1Time: "MMDDhhmm",
+	DICPositiveActiveMaxDemandRate2Time: "MMDDhhmm",
+	DICPositiveActiveMaxDemandRate3Time: "MMDDhhmm",
+	DICPositiveActiveMaxDemandRate4Time: "MMDDhhmm",
+	DICPositiveActiveMaxDemandTimeBlock: "MMDDhhmm",
+	DICNegativeActiveMaxDemandTime:      "MMDDhhmm",
+	DICPositiveReactiveMaxDemandTime:    "MMDDhhmm",
+	DICNegativeReactiveMaxDemandTime:    "MMDDhhmm",
+	DICLastProgramTime:                  "MMDDhhmm",
+	DICLastDemandResetTime:              "MMDDhhmm",
+	DICTotalPhaseBreakCount:             "NNNN",
+	DICPhaseABreakCount:                 "NNNN",
+	DICPhaseBBreakCount:                 "NNNN",
+	DICPhaseCBreakCount:                 "NNNN",
+	DICPhaseBreakCount:                  "NNNN",
+	DICTotalPhaseBreakTime:              "NNNNNN",
+	DICPhaseABreakTime:                  "NNNNNN",
+	DICPhaseBBreakTime:                  "NNNNNN",
+	DICPhaseCBreakTime:                  "NNNNNN",
+	DICPhaseBreakTime:                   "NNNNNN",
+	DICTotalPhaseLastBreakStartTime:     "MMDDhhmm",
+	DICPhaseALastBreakStartTime:         "MMDDhhmm",
+	DICPhaseBLastBreakStartTime:         "MMDDhhmm",
+	DICPhaseCLastBreakStartTime:         "MMDDhhmm",
+	DICPhaseLastBreakStartTime:          "MMDDhhmm",
+	DICTotalPhaseLastBreakEndTime:       "MMDDhhmm",
+	DICPhaseALastBreakEndTime:           "MMDDhhmm",
+	DICPhaseBLastBreakEndTime:           "MMDDhhmm",
+	DICPhaseCLastBreakEndTime:           "MMDDhhmm",
+	DICPhaseLastBreakEndTime:            "MMDDhhmm",
+	DICMeterStatusWord:                  "",
+	DICGridStatusWord:                   "",
 }
 
 // OldFormat is the attribute of DIC.
@@ -479,59 +1996,337 @@ func (x DIC) OldFormat() string {
 }
 
 var _DICMapOldSize = map[DIC]int{
-	DICTotalActiveEnergy:             0,
-	DICPositiveTotalActiveEnergy:     0,
-	DICNegativeTotalActiveEnergy:     0,
-	DICTotalReactiveEnergy1:          0,
-	DICTotalReactiveEnergy2:          0,
-	DICFirstQuadrantReactiveEnergy:   0,
-	DICSecondQuadrantReactiveEnergy:  0,
-	DICThirdQuadrantReactiveEnergy:   0,
-	DICFourthQuadrantReactiveEnergy:  0,
-	DICPositiveTotalApparentEnergy:   0,
-	DICNegativeTotalApparentEnergy:   0,
-	DICAssociatedTotalElectricEnergy: 0,
-	DICPhaseAVoltage:                 2,
-	DICPhaseBVoltage:                 2,
-	DICPhaseCVoltage:                 2,
-	DICVoltage:                       0,
-	DICPhaseACurrent:                 2,
-	DICPhaseBCurrent:                 2,
-	DICPhaseCCurrent:                 2,
-	DICCurrent:                       0,
-	DICTotalActivePower:              3,
-	DICPhaseAActivePower:             3,
-	DICPhaseBActivePower:             3,
-	DICPhaseCActivePower:             3,
-	DICActivePower:                   0,
-	DICTotalReactivePower:            0,
-	DICPhaseAReactivePower:           0,
-	DICPhaseBReactivePower:           0,
-	DICPhaseCReactivePower:           0,
-	DICReactivePower:                 0,
-	DICTotalApparentPower:            0,
-	DICPhaseAApparentPower:           0,
-	DICPhaseBApparentPower:           0,
-	DICPhaseCApparentPower:           0,
-	DICApparentPower:                 0,
-	DICTotalPowerFactor:              0,
-	DICPhaseAPowerFactor:             0,
-	DICPhaseBPowerFactor:             0,
-	DICPhaseCPowerFactor:             0,
-	DICPowerFactor:                   0,
-	DICABLineVoltage:                 2,
-	DICBCLineVoltage:                 2,
-	DICCALineVoltage:                 2,
-	DICLineVoltage:                   0,
-	DICFrequency:                     0,
-	DICTotalOverCurrentCount:         0,
-	DICTotalMeterResetCount:          0,
-	DICMeterResetRecord:              0,
-	DICDateTime:                      0,
-	DICTime:                          0,
-	DICAssetManagementCode:           0,
-	DICActiveConstant:                0,
-	DICReactiveConstant:              0,
+	DICTotalActiveEnergy:                0,
+	DICPositiveTotalActiveEnergy:        4,
+	DICPositiveActiveEnergyRate1:        4,
+	DICPositiveActiveEnergyRate2:        4,
+	DICPositiveActiveEnergyRate3:        4,
+	DICPositiveActiveEnergyRate4:        4,
+	DICPositiveActiveEnergy:             4,
+	DICNegativeTotalActiveEnergy:        4,
+	DICNegativeActiveEnergyRate1:        4,
+	DICNegativeActiveEnergyRate2:        4,
+	DICNegativeActiveEnergyRate3:        4,
+	DICNegativeActiveEnergyRate4:        4,
+	DICNegativeActiveEnergy:             4,
+	DICTotalReactiveEnergy1:             0,
+	DICReactiveEnergy1Rate1:             0,
+	DICReactiveEnergy1Rate2:             0,
+	DICReactiveEnergy1Rate3:             0,
+	DICReactiveEnergy1Rate4:             0,
+	DICReactiveEnergy1:                  0,
+	DICTotalReactiveEnergy2:             0,
+	DICReactiveEnergy2Rate1:             0,
+	DICReactiveEnergy2Rate2:             0,
+	DICReactiveEnergy2Rate3:             0,
+	DICReactiveEnergy2Rate4:             0,
+	DICReactiveEnergy2:                  0,
+	DICFirstQuadrantReactiveEnergy:      4,
+	DICSecondQuadrantReactiveEnergy:     4,
+	DICThirdQuadrantReactiveEnergy:      4,
+	DICFourthQuadrantReactiveEnergy:     4,
+	DICPositiveTotalApparentEnergy:      0,
+	DICNegativeTotalApparentEnergy:      0,
+	DICAssociatedTotalElectricEnergy:    0,
+	DICRemainingEnergy:                  0,
+	DICOverdraftEnergy:                  0,
+	DICRemainingAmount:                  0,
+	DICOverdraftAmount:                  0,
+	DICPositiveActiveMaxDemand:          3,
+	DICPositiveActiveMaxDemandRate1:     3,
+	DICPositiveActiveMaxDemandRate2:     3,
+	DICPositiveActiveMaxDemandRate3:     3,
+	DICPositiveActiveMaxDemandRate4:     3,
+	DICPositiveActiveMaxDemandBlock:     3,
+	DICNegativeActiveMaxDemand:          3,
+	DICReactiveMaxDemand1:               0,
+	DICReactiveMaxDemand2:               0,
+	DICPhaseAVoltage:                    2,
+	DICPhaseBVoltage:                    2,
+	DICPhaseCVoltage:                    2,
+	DICVoltage:                          2,
+	DICPhaseACurrent:                    2,
+	DICPhaseBCurrent:                    2,
+	DICPhaseCCurrent:                    2,
+	DICCurrent:                          2,
+	DICTotalActivePower:                 3,
+	DICPhaseAActivePower:                3,
+	DICPhaseBActivePower:                3,
+	DICPhaseCActivePower:                3,
+	DICActivePower:                      3,
+	DICTotalReactivePower:               2,
+	DICPhaseAReactivePower:              2,
+	DICPhaseBReactivePower:              2,
+	DICPhaseCReactivePower:              2,
+	DICReactivePower:                    2,
+	DICTotalApparentPower:               0,
+	DICPhaseAApparentPower:              0,
+	DICPhaseBApparentPower:              0,
+	DICPhaseCApparentPower:              0,
+	DICApparentPower:                    0,
+	DICTotalPowerFactor:                 2,
+	DICPhaseAPowerFactor:                2,
+	DICPhaseBPowerFactor:                2,
+	DICPhaseCPowerFactor:                2,
+	DICPowerFactor:                      2,
+	DICPhaseAAngle:                      0,
+	DICPhaseBAngle:                      0,
+	DICPhaseCAngle:                      0,
+	DICPhaseAngle:                       0,
+	DICPhaseAVoltageTHD:                 0,
+	DICPhaseAVoltageHarmonic2:           0,
+	DICPhaseAVoltageHarmonic3:           0,
+	DICPhaseAVoltageHarmonic4:           0,
+	DICPhaseAVoltageHarmonic5:           0,
+	DICPhaseAVoltageHarmonic6:           0,
+	DICPhaseAVoltageHarmonic7:           0,
+	DICPhaseAVoltageHarmonic8:           0,
+	DICPhaseAVoltageHarmonic9:           0,
+	DICPhaseAVoltageHarmonic10:          0,
+	DICPhaseAVoltageHarmonic11:          0,
+	DICPhaseAVoltageHarmonic12:          0,
+	DICPhaseAVoltageHarmonic13:          0,
+	DICPhaseAVoltageHarmonic14:          0,
+	DICPhaseAVoltageHarmonic15:          0,
+	DICPhaseAVoltageHarmonic16:          0,
+	DICPhaseAVoltageHarmonic17:          0,
+	DICPhaseAVoltageHarmonic18:          0,
+	DICPhaseAVoltageHarmonic19:          0,
+	DICPhaseAVoltageHarmonic20:          0,
+	DICPhaseAVoltageHarmonic21:          0,
+	DICPhaseAVoltageHarmonic:            0,
+	DICPhaseBVoltageTHD:                 0,
+	DICPhaseBVoltageHarmonic2:           0,
+	DICPhaseBVoltageHarmonic3:           0,
+	DICPhaseBVoltageHarmonic4:           0,
+	DICPhaseBVoltageHarmonic5:           0,
+	DICPhaseBVoltageHarmonic6:           0,
+	DICPhaseBVoltageHarmonic7:           0,
+	DICPhaseBVoltageHarmonic8:           0,
+	DICPhaseBVoltageHarmonic9:           0,
+	DICPhaseBVoltageHarmonic10:          0,
+	DICPhaseBVoltageHarmonic11:          0,
+	DICPhaseBVoltageHarmonic12:          0,
+	DICPhaseBVoltageHarmonic13:          0,
+	DICPhaseBVoltageHarmonic14:          0,
+	DICPhaseBVoltageHarmonic15:          0,
+	DICPhaseBVoltageHarmonic16:          0,
+	DICPhaseBVoltageHarmonic17:          0,
+	DICPhaseBVoltageHarmonic18:          0,
+	DICPhaseBVoltageHarmonic19:          0,
+	DICPhaseBVoltageHarmonic20:          0,
+	DICPhaseBVoltageHarmonic21:          0,
+	DICPhaseBVoltageHarmonic:            0,
+	DICPhaseCVoltageTHD:                 0,
+	DICPhaseCVoltageHarmonic2:           0,
+	DICPhaseCVoltageHarmonic3:           0,
+	DICPhaseCVoltageHarmonic4:           0,
+	DICPhaseCVoltageHarmonic5:           0,
+	DICPhaseCVoltageHarmonic6:           0,
+	DICPhaseCVoltageHarmonic7:           0,
+	DICPhaseCVoltageHarmonic8:           0,
+	DICPhaseCVoltageHarmonic9:           0,
+	DICPhaseCVoltageHarmonic10:          0,
+	DICPhaseCVoltageHarmonic11:          0,
+	DICPhaseCVoltageHarmonic12:          0,
+	DICPhaseCVoltageHarmonic13:          0,
+	DICPhaseCVoltageHarmonic14:          0,
+	DICPhaseCVoltageHarmonic15:          0,
+	DICPhaseCVoltageHarmonic16:          0,
+	DICPhaseCVoltageHarmonic17:          0,
+	DICPhaseCVoltageHarmonic18:          0,
+	DICPhaseCVoltageHarmonic19:          0,
+	DICPhaseCVoltageHarmonic20:          0,
+	DICPhaseCVoltageHarmonic21:          0,
+	DICPhaseCVoltageHarmonic:            0,
+	DICPhaseACurrentTHD:                 0,
+	DICPhaseACurrentHarmonic2:           0,
+	DICPhaseACurrentHarmonic3:           0,
+	DICPhaseACurrentHarmonic4:           0,
+	DICPhaseACurrentHarmonic5:           0,
+	DICPhaseACurrentHarmonic6:           0,
+	DICPhaseACurrentHarmonic7:           0,
+	DICPhaseACurrentHarmonic8:           0,
+	DICPhaseACurrentHarmonic9:           0,
+	DICPhaseACurrentHarmonic10:          0,
+	DICPhaseACurrentHarmonic11:          0,
+	DICPhaseACurrentHarmonic12:          0,
+	DICPhaseACurrentHarmonic13:          0,
+	DICPhaseACurrentHarmonic14:          0,
+	DICPhaseACurrentHarmonic15:          0,
+	DICPhaseACurrentHarmonic16:          0,
+	DICPhaseACurrentHarmonic17:          0,
+	DICPhaseACurrentHarmonic18:          0,
+	DICPhaseACurrentHarmonic19:          0,
+	DICPhaseACurrentHarmonic20:          0,
+	DICPhaseACurrentHarmonic21:          0,
+	DICPhaseACurrentHarmonic:            0,
+	DICPhaseBCurrentTHD:                 0,
+	DICPhaseBCurrentHarmonic2:           0,
+	DICPhaseBCurrentHarmonic3:           0,
+	DICPhaseBCurrentHarmonic4:           0,
+	DICPhaseBCurrentHarmonic5:           0,
+	DICPhaseBCurrentHarmonic6:           0,
+	DICPhaseBCurrentHarmonic7:           0,
+	DICPhaseBCurrentHarmonic8:           0,
+	DICPhaseBCurrentHarmonic9:           0,
+	DICPhaseBCurrentHarmonic10:          0,
+	DICPhaseBCurrentHarmonic11:          0,
+	DICPhaseBCurrentHarmonic12:          0,
+	DICPhaseBCurrentHarmonic13:          0,
+	DICPhaseBCurrentHarmonic14:          0,
+	DICPhaseBCurrentHarmonic15:          0,
+	DICPhaseBCurrentHarmonic16:          0,
+	DICPhaseBCurrentHarmonic17:          0,
+	DICPhaseBCurrentHarmonic18:          0,
+	DICPhaseBCurrentHarmonic19:          0,
+	DICPhaseBCurrentHarmonic20:          0,
+	DICPhaseBCurrentHarmonic21:          0,
+	DICPhaseBCurrentHarmonic:            0,
+	DICPhaseCCurrentTHD:                 0,
+	DICPhaseCCurrentHarmonic2:           0,
+	DICPhaseCCurrentHarmonic3:           0,
+	DICPhaseCCurrentHarmonic4:           0,
+	DICPhaseCCurrentHarmonic5:           0,
+	DICPhaseCCurrentHarmonic6:           0,
+	DICPhaseCCurrentHarmonic7:           0,
+	DICPhaseCCurrentHarmonic8:           0,
+	DICPhaseCCurrentHarmonic9:           0,
+	DICPhaseCCurrentHarmonic10:          0,
+	DICPhaseCCurrentHarmonic11:          0,
+	DICPhaseCCurrentHarmonic12:          0,
+	DICPhaseCCurrentHarmonic13:          0,
+	DICPhaseCCurrentHarmonic14:          0,
+	DICPhaseCCurrentHarmonic15:          0,
+	DICPhaseCCurrentHarmonic16:          0,
+	DICPhaseCCurrentHarmonic17:          0,
+	DICPhaseCCurrentHarmonic18:          0,
+	DICPhaseCCurrentHarmonic19:          0,
+	DICPhaseCCurrentHarmonic20:          0,
+	DICPhaseCCurrentHarmonic21:          0,
+	DICPhaseCCurrentHarmonic:            0,
+	DICABLineVoltage:                    2,
+	DICBCLineVoltage:                    2,
+	DICCALineVoltage:                    2,
+	DICLineVoltage:                      0,
+	DICNeutralCurrent:                   0,
+	DICFrequency:                        0,
+	DICAverageActivePower:               0,
+	DICActiveDemand:                     0,
+	DICReactiveDemand:                   0,
+	DICApparentDemand:                   0,
+	DICTemperature:                      0,
+	DICClockBatteryVoltage:              0,
+	DICReadingBatteryVoltage:            0,
+	DICBatteryRunTime:                   3,
+	DICCurrentTariffPrice:               0,
+	DICTotalOverCurrentCount:            0,
+	DICTotalPowerDownCount:              0,
+	DICPowerDownRecord:                  0,
+	DICTotalProgramCount:                2,
+	DICProgramRecord:                    0,
+	DICTotalMeterResetCount:             0,
+	DICMeterResetRecord:                 0,
+	DICTotalDemandResetCount:            2,
+	DICDemandResetRecord:                0,
+	DICTotalEventResetCount:             0,
+	DICEventResetRecord:                 0,
+	DICTotalClockAdjustCount:            0,
+	DICClockAdjustRecord:                0,
+	DICTotalMeterCoverOpenCount:         0,
+	DICMeterCoverOpenRecord:             0,
+	DICTotalTerminalCoverOpenCount:      0,
+	DICTerminalCoverOpenRecord:          0,
+	DICLastPurchaseTime:                 0,
+	DICLastPurchaseCount:                0,
+	DICLastPurchaseAmount:               0,
+	DICLastPurchaseBalanceBefore:        0,
+	DICLastPurchaseBalanceAfter:         0,
+	DICLastTotalPurchaseAmount:          0,
+	DICDateTime:                         4,
+	DICTime:                             3,
+	DICDemandPeriod:                     1,
+	DICSlidingTime:                      1,
+	DICTimeZoneSwitchTime:               0,
+	DICDailyTableSwitchTime:             0,
+	DICTariffSwitchTime:                 0,
+	DICStepSwitchTime:                   0,
+	DICTimeZoneCount:                    1,
+	DICDailyTableCount:                  1,
+	DICPeriodCount:                      1,
+	DICTariffCount:                      1,
+	DICHolidayCount:                     1,
+	DICMeterNumber:                      6,
+	DICAssetManagementCode:              0,
+	DICActiveConstant:                   3,
+	DICReactiveConstant:                 3,
+	DICUserNumber:                       6,
+	DICRunningStatusWord1:               0,
+	DICRunningStatusWord2:               0,
+	DICRunningStatusWord3:               0,
+	DICRunningStatusWord4:               0,
+	DICRunningStatusWord5:               0,
+	DICRunningStatusWord6:               0,
+	DICRunningStatusWord7:               0,
+	DICRunningStatusWord:                0,
+	DICAlarmAmount1Limit:                0,
+	DICAlarmAmount2Limit:                0,
+	DICOverdraftAmountLimit:             0,
+	DICHoardingAmountLimit:              0,
+	DICCloseAllowedAmountLimit:          0,
+	DICActiveReportStatusWord:           0,
+	DICFirstTimeZoneTable:               0,
+	DICFirstDailyTable1:                 0,
+	DICSecondTimeZoneTable:              0,
+	DICSecondDailyTable1:                0,
+	DICHoliday1:                         0,
+	DICPositiveTotalReactiveEnergy:      4,
+	DICPositiveReactiveEnergyRate1:      4,
+	DICPositiveReactiveEnergyRate2:      4,
+	DICPositiveReactiveEnergyRate3:      4,
+	DICPositiveReactiveEnergyRate4:      4,
+	DICPositiveReactiveEnergy:           4,
+	DICNegativeTotalReactiveEnergy:      4,
+	DICNegativeReactiveEnergyRate1:      4,
+	DICNegativeReactiveEnergyRate2:      4,
+	DICNegativeReactiveEnergyRate3:      4,
+	DICNegativeReactiveEnergyRate4:      4,
+	DICNegativeReactiveEnergy:           4,
+	DICPositiveReactiveMaxDemand:        3,
+	DICNegativeReactiveMaxDemand:        3,
+	DICPositiveActiveMaxDemandTime:      4,
+	DICPositiveActiveMaxDemandRate1Time: 4,
+	DICPositiveActiveMaxDemandRate2Time: 4,
+	DICPositiveActiveMaxDemandRate3Time: 4,
+	DICPositiveActiveMaxDemandRate4Time: 4,
+	DICPositiveActiveMaxDemandTimeBlock: 4,
+	DICNegativeActiveMaxDemandTime:      4,
+	DICPositiveReactiveMaxDemandTime:    4,
+	DICNegativeReactiveMaxDemandTime:    4,
+	DICLastProgramTime:                  4,
+	DICLastDemandResetTime:              4,
+	DICTotalPhaseBreakCount:             2,
+	DICPhaseABreakCount:                 2,
+	DICPhaseBBreakCount:                 2,
+	DICPhaseCBreakCount:                 2,
+	DICPhaseBreakCount:                  2,
+	DICTotalPhaseBreakTime:              3,
+	DICPhaseABreakTime:                  3,
+	DICPhaseBBreakTime:                  3,
+	DICPhaseCBreakTime:                  3,
+	DICPhaseBreakTime:                   3,
+	DICTotalPhaseLastBreakStartTime:     4,
+	DICPhaseALastBreakStartTime:         4,
+	DICPhaseBLastBreakStartTime:         4,
+	DICPhaseCLastBreakStartTime:         4,
+	DICPhaseLastBreakStartTime:          4,
+	DICTotalPhaseLastBreakEndTime:       4,
+	DICPhaseALastBreakEndTime:           4,
+	DICPhaseBLastBreakEndTime:           4,
+	DICPhaseCLastBreakEndTime:           4,
+	DICPhaseLastBreakEndTime:            4,
+	DICMeterStatusWord:                  1,
+	DICGridStatusWord:                   1,
 }
 
 // OldSize is the attribute of DIC.
@@ -543,59 +2338,337 @@ func (x DIC) OldSize() int {
 }
 
 var _DICMapNewFormat = map[DIC]string{
-	DICTotalActiveEnergy:             "XXXXXX.XX",
-	DICPositiveTotalActiveEnergy:     "XXXXXX.XX",
-	DICNegativeTotalActiveEnergy:     "XXXXXX.XX",
-	DICTotalReactiveEnergy1:          "XXXXXX.XX",
-	DICTotalReactiveEnergy2:          "XXXXXX.XX",
-	DICFirstQuadrantReactiveEnergy:   "XXXXXX.XX",
-	DICSecondQuadrantReactiveEnergy:  "XXXXXX.XX",
-	DICThirdQuadrantReactiveEnergy:   "XXXXXX.XX",
-	DICFourthQuadrantReactiveEnergy:  "XXXXXX.XX",
-	DICPositiveTotalApparentEnergy:   "XXXXXX.XX",
-	DICNegativeTotalApparentEnergy:   "XXXXXX.XX",
-	DICAssociatedTotalElectricEnergy: "XXXXXX.XX",
-	DICPhaseAVoltage:                 "XXX.X",
-	DICPhaseBVoltage:                 "XXX.X",
-	DICPhaseCVoltage:                 "XXX.X",
-	DICVoltage:                       "XXX.X",
-	DICPhaseACurrent:                 "XXX.XXX",
-	DICPhaseBCurrent:                 "XXX.XXX",
-	DICPhaseCCurrent:                 "XXX.XXX",
-	DICCurrent:                       "XXX.XXX",
-	DICTotalActivePower:              "XX.XXXX",
-	DICPhaseAActivePower:             "XX.XXXX",
-	DICPhaseBActivePower:             "XX.XXXX",
-	DICPhaseCActivePower:             "XX.XXXX",
-	DICActivePower:                   "XX.XXXX",
-	DICTotalReactivePower:            "XX.XXXX",
-	DICPhaseAReactivePower:           "XX.XXXX",
-	DICPhaseBReactivePower:           "XX.XXXX",
-	DICPhaseCReactivePower:           "XX.XXXX",
-	DICReactivePower:                 "XX.XXXX",
-	DICTotalApparentPower:            "XX.XXXX",
-	DICPhaseAApparentPower:           "XX.XXXX",
-	DICPhaseBApparentPower:           "XX.XXXX",
-	DICPhaseCApparentPower:           "XX.XXXX",
-	DICApparentPower:                 "XX.XXXX",
-	DICTotalPowerFactor:              "X.XXX",
-	DICPhaseAPowerFactor:             "X.XXX",
-	DICPhaseBPowerFactor:             "X.XXX",
-	DICPhaseCPowerFactor:             "X.XXX",
-	DICPowerFactor:                   "X.XXX",
-	DICABLineVoltage:                 "XXX.X",
-	DICBCLineVoltage:                 "XXX.X",
-	DICCALineVoltage:                 "XXX.X",
-	DICLineVoltage:                   "XXX.X",
-	DICFrequency:                     "XX.XX",
-	DICTotalOverCurrentCount:         "XXXXXX, XXXXXX",
-	DICTotalMeterResetCount:          "XXXXXX",
-	DICMeterResetRecord:              "",
-	DICDateTime:                      "YYMMDDWW",
-	DICTime:                          "hhmmss",
-	DICAssetManagementCode:           "N",
-	DICActiveConstant:                "XXXXXX",
-	DICReactiveConstant:              "XXXXXX",
+	DICTotalActiveEnergy:                "XXXXXX.XX",
+	DICPositiveTotalActiveEnergy:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate1:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate2:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate3:        "XXXXXX.XX",
+	DICPositiveActiveEnergyRate4:        "XXXXXX.XX",
+	DICPositiveActiveEnergy:             "XXXXXX.XX",
+	DICNegativeTotalActiveEnergy:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate1:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate2:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate3:        "XXXXXX.XX",
+	DICNegativeActiveEnergyRate4:        "XXXXXX.XX",
+	DICNegativeActiveEnergy:             "XXXXXX.XX",
+	DICTotalReactiveEnergy1:             "XXXXXX.XX",
+	DICReactiveEnergy1Rate1:             "XXXXXX.XX",
+	DICReactiveEnergy1Rate2:             "XXXXXX.XX",
+	DICReactiveEnergy1Rate3:             "XXXXXX.XX",
+	DICReactiveEnergy1Rate4:             "XXXXXX.XX",
+	DICReactiveEnergy1:                  "XXXXXX.XX",
+	DICTotalReactiveEnergy2:             "XXXXXX.XX",
+	DICReactiveEnergy2Rate1:             "XXXXXX.XX",
+	DICReactiveEnergy2Rate2:             "XXXXXX.XX",
+	DICReactiveEnergy2Rate3:             "XXXXXX.XX",
+	DICReactiveEnergy2Rate4:             "XXXXXX.XX",
+	DICReactiveEnergy2:                  "XXXXXX.XX",
+	DICFirstQuadrantReactiveEnergy:      "XXXXXX.XX",
+	DICSecondQuadrantReactiveEnergy:     "XXXXXX.XX",
+	DICThirdQuadrantReactiveEnergy:      "XXXXXX.XX",
+	DICFourthQuadrantReactiveEnergy:     "XXXXXX.XX",
+	DICPositiveTotalApparentEnergy:      "XXXXXX.XX",
+	DICNegativeTotalApparentEnergy:      "XXXXXX.XX",
+	DICAssociatedTotalElectricEnergy:    "XXXXXX.XX",
+	DICRemainingEnergy:                  "XXXXXX.XX",
+	DICOverdraftEnergy:                  "XXXXXX.XX",
+	DICRemainingAmount:                  "XXXXXX.XX",
+	DICOverdraftAmount:                  "XXXXXX.XX",
+	DICPositiveActiveMaxDemand:          "XX.XXXX,YYMMDDhhmm",
+	DICPositiveActiveMaxDemandRate1:     "XX.XXXX,YYMMDDhhmm",
+	DICPositiveActiveMaxDemandRate2:     "XX.XXXX,YYMMDDhhmm",
+	DICPositiveActiveMaxDemandRate3:     "XX.XXXX,YYMMDDhhmm",
+	DICPositiveActiveMaxDemandRate4:     "XX.XXXX,YYMMDDhhmm",
+	DICPositiveActiveMaxDemandBlock:     "XX.XXXX,YYMMDDhhmm",
+	DICNegativeActiveMaxDemand:          "XX.XXXX,YYMMDDhhmm",
+	DICReactiveMaxDemand1:               "XX.XXXX,YYMMDDhhmm",
+	DICReactiveMaxDemand2:               "XX.XXXX,YYMMDDhhmm",
+	DICPhaseAVoltage:                    "XXX.X",
+	DICPhaseBVoltage:                    "XXX.X",
+	DICPhaseCVoltage:                    "XXX.X",
+	DICVoltage:                          "XXX.X",
+	DICPhaseACurrent:                    "XXX.XXX",
+	DICPhaseBCurrent:                    "XXX.XXX",
+	DICPhaseCCurrent:                    "XXX.XXX",
+	DICCurrent:                          "XXX.XXX",
+	DICTotalActivePower:                 "XX.XXXX",
+	DICPhaseAActivePower:                "XX.XXXX",
+	DICPhaseBActivePower:                "XX.XXXX",
+	DICPhaseCActivePower:                "XX.XXXX",
+	DICActivePower:                      "XX.XXXX",
+	DICTotalReactivePower:               "XX.XXXX",
+	DICPhaseAReactivePower:              "XX.XXXX",
+	DICPhaseBReactivePower:              "XX.XXXX",
+	DICPhaseCReactivePower:              "XX.XXXX",
+	DICReactivePower:                    "XX.XXXX",
+	DICTotalApparentPower:               "XX.XXXX",
+	DICPhaseAApparentPower:              "XX.XXXX",
+	DICPhaseBApparentPower:              "XX.XXXX",
+	DICPhaseCApparentPower:              "XX.XXXX",
+	DICApparentPower:                    "XX.XXXX",
+	DICTotalPowerFactor:                 "X.XXX",
+	DICPhaseAPowerFactor:                "X.XXX",
+	DICPhaseBPowerFactor:                "X.XXX",
+	DICPhaseCPowerFactor:                "X.XXX",
+	DICPowerFactor:                      "X.XXX",
+	DICPhaseAAngle:                      "XXX.X",
+	DICPhaseBAngle:                      "XXX.X",
+	DICPhaseCAngle:                      "XXX.X",
+	DICPhaseAngle:                       "XXX.X",
+	DICPhaseAVoltageTHD:                 "XX.XX",
+	DICPhaseAVoltageHarmonic2:           "XX.XX",
+	DICPhaseAVoltageHarmonic3:           "XX.XX",
+	DICPhaseAVoltageHarmonic4:           "XX.XX",
+	DICPhaseAVoltageHarmonic5:           "XX.XX",
+	DICPhaseAVoltageHarmonic6:           "XX.XX",
+	DICPhaseAVoltageHarmonic7:           "XX.XX",
+	DICPhaseAVoltageHarmonic8:           "XX.XX",
+	DICPhaseAVoltageHarmonic9:           "XX.XX",
+	DICPhaseAVoltageHarmonic10:          "XX.XX",
+	DICPhaseAVoltageHarmonic11:          "XX.XX",
+	DICPhaseAVoltageHarmonic12:          "XX.XX",
+	DICPhaseAVoltageHarmonic13:          "XX.XX",
+	DICPhaseAVoltageHarmonic14:          "XX.XX",
+	DICPhaseAVoltageHarmonic15:          "XX.XX",
+	DICPhaseAVoltageHarmonic16:          "XX.XX",
+	DICPhaseAVoltageHarmonic17:          "XX.XX",
+	DICPhaseAVoltageHarmonic18:          "XX.XX",
+	DICPhaseAVoltageHarmonic19:          "XX.XX",
+	DICPhaseAVoltageHarmonic20:          "XX.XX",
+	DICPhaseAVoltageHarmonic21:          "XX.XX",
+	DICPhaseAVoltageHarmonic:            "XX.XX",
+	DICPhaseBVoltageTHD:                 "XX.XX",
+	DICPhaseBVoltageHarmonic2:           "XX.XX",
+	DICPhaseBVoltageHarmonic3:           "XX.XX",
+	DICPhaseBVoltageHarmonic4:           "XX.XX",
+	DICPhaseBVoltageHarmonic5:           "XX.XX",
+	DICPhaseBVoltageHarmonic6:           "XX.XX",
+	DICPhaseBVoltageHarmonic7:           "XX.XX",
+	DICPhaseBVoltageHarmonic8:           "XX.XX",
+	DICPhaseBVoltageHarmonic9:           "XX.XX",
+	DICPhaseBVoltageHarmonic10:          "XX.XX",
+	DICPhaseBVoltageHarmonic11:          "XX.XX",
+	DICPhaseBVoltageHarmonic12:          "XX.XX",
+	DICPhaseBVoltageHarmonic13:          "XX.XX",
+	DICPhaseBVoltageHarmonic14:          "XX.XX",
+	DICPhaseBVoltageHarmonic15:          "XX.XX",
+	DICPhaseBVoltageHarmonic16:          "XX.XX",
+	DICPhaseBVoltageHarmonic17:          "XX.XX",
+	DICPhaseBVoltageHarmonic18:          "XX.XX",
+	DICPhaseBVoltageHarmonic19:          "XX.XX",
+	DICPhaseBVoltageHarmonic20:          "XX.XX",
+	DICPhaseBVoltageHarmonic21:          "XX.XX",
+	DICPhaseBVoltageHarmonic:            "XX.XX",
+	DICPhaseCVoltageTHD:                 "XX.XX",
+	DICPhaseCVoltageHarmonic2:           "XX.XX",
+	DICPhaseCVoltageHarmonic3:           "XX.XX",
+	DICPhaseCVoltageHarmonic4:           "XX.XX",
+	DICPhaseCVoltageHarmonic5:           "XX.XX",
+	DICPhaseCVoltageHarmonic6:           "XX.XX",
+	DICPhaseCVoltageHarmonic7:           "XX.XX",
+	DICPhaseCVoltageHarmonic8:           "XX.XX",
+	DICPhaseCVoltageHarmonic9:           "XX.XX",
+	DICPhaseCVoltageHarmonic10:          "XX.XX",
+	DICPhaseCVoltageHarmonic11:          "XX.XX",
+	DICPhaseCVoltageHarmonic12:          "XX.XX",
+	DICPhaseCVoltageHarmonic13:          "XX.XX",
+	DICPhaseCVoltageHarmonic14:          "XX.XX",
+	DICPhaseCVoltageHarmonic15:          "XX.XX",
+	DICPhaseCVoltageHarmonic16:          "XX.XX",
+	DICPhaseCVoltageHarmonic17:          "XX.XX",
+	DICPhaseCVoltageHarmonic18:          "XX.XX",
+	DICPhaseCVoltageHarmonic19:          "XX.XX",
+	DICPhaseCVoltageHarmonic20:          "XX.XX",
+	DICPhaseCVoltageHarmonic21:          "XX.XX",
+	DICPhaseCVoltageHarmonic:            "XX.XX",
+	DICPhaseACurrentTHD:                 "XX.XX",
+	DICPhaseACurrentHarmonic2:           "XX.XX",
+	DICPhaseACurrentHarmonic3:           "XX.XX",
+	DICPhaseACurrentHarmonic4:           "XX.XX",
+	DICPhaseACurrentHarmonic5:           "XX.XX",
+	DICPhaseACurrentHarmonic6:           "XX.XX",
+	DICPhaseACurrentHarmonic7:           "XX.XX",
+	DICPhaseACurrentHarmonic8:           "XX.XX",
+	DICPhaseACurrentHarmonic9:           "XX.XX",
+	DICPhaseACurrentHarmonic10:          "XX.XX",
+	DICPhaseACurrentHarmonic11:          "XX.XX",
+	DICPhaseACurrentHarmonic12:          "XX.XX",
+	DICPhaseACurrentHarmonic13:          "XX.XX",
+	DICPhaseACurrentHarmonic14:          "XX.XX",
+	DICPhaseACurrentHarmonic15:          "XX.XX",
+	DICPhaseACurrentHarmonic16:          "XX.XX",
+	DICPhaseACurrentHarmonic17:          "XX.XX",
+	DICPhaseACurrentHarmonic18:          "XX.XX",
+	DICPhaseACurrentHarmonic19:          "XX.XX",
+	DICPhaseACurrentHarmonic20:          "XX.XX",
+	DICPhaseACurrentHarmonic21:          "XX.XX",
+	DICPhaseACurrentHarmonic:            "XX.XX",
+	DICPhaseBCurrentTHD:                 "XX.XX",
+	DICPhaseBCurrentHarmonic2:           "XX.XX",
+	DICPhaseBCurrentHarmonic3:           "XX.XX",
+	DICPhaseBCurrentHarmonic4:           "XX.XX",
+	DICPhaseBCurrentHarmonic5:           "XX.XX",
+	DICPhaseBCurrentHarmonic6:           "XX.XX",
+	DICPhaseBCurrentHarmonic7:           "XX.XX",
+	DICPhaseBCurrentHarmonic8:           "XX.XX",
+	DICPhaseBCurrentHarmonic9:           "XX.XX",
+	DICPhaseBCurrentHarmonic10:          "XX.XX",
+	DICPhaseBCurrentHarmonic11:          "XX.XX",
+	DICPhaseBCurrentHarmonic12:          "XX.XX",
+	DICPhaseBCurrentHarmonic13:          "XX.XX",
+	DICPhaseBCurrentHarmonic14:          "XX.XX",
+	DICPhaseBCurrentHarmonic15:          "XX.XX",
+	DICPhaseBCurrentHarmonic16:          "XX.XX",
+	DICPhaseBCurrentHarmonic17:          "XX.XX",
+	DICPhaseBCurrentHarmonic18:          "XX.XX",
+	DICPhaseBCurrentHarmonic19:          "XX.XX",
+	DICPhaseBCurrentHarmonic20:          "XX.XX",
+	DICPhaseBCurrentHarmonic21:          "XX.XX",
+	DICPhaseBCurrentHarmonic:            "XX.XX",
+	DICPhaseCCurrentTHD:                 "XX.XX",
+	DICPhaseCCurrentHarmonic2:           "XX.XX",
+	DICPhaseCCurrentHarmonic3:           "XX.XX",
+	DICPhaseCCurrentHarmonic4:           "XX.XX",
+	DICPhaseCCurrentHarmonic5:           "XX.XX",
+	DICPhaseCCurrentHarmonic6:           "XX.XX",
+	DICPhaseCCurrentHarmonic7:           "XX.XX",
+	DICPhaseCCurrentHarmonic8:           "XX.XX",
+	DICPhaseCCurrentHarmonic9:           "XX.XX",
+	DICPhaseCCurrentHarmonic10:          "XX.XX",
+	DICPhaseCCurrentHarmonic11:          "XX.XX",
+	DICPhaseCCurrentHarmonic12:          "XX.XX",
+	DICPhaseCCurrentHarmonic13:          "XX.XX",
+	DICPhaseCCurrentHarmonic14:          "XX.XX",
+	DICPhaseCCurrentHarmonic15:          "XX.XX",
+	DICPhaseCCurrentHarmonic16:          "XX.XX",
+	DICPhaseCCurrentHarmonic17:          "XX.XX",
+	DICPhaseCCurrentHarmonic18:          "XX.XX",
+	DICPhaseCCurrentHarmonic19:          "XX.XX",
+	DICPhaseCCurrentHarmonic20:          "XX.XX",
+	DICPhaseCCurrentHarmonic21:          "XX.XX",
+	DICPhaseCCurrentHarmonic:            "XX.XX",
+	DICABLineVoltage:                    "XXX.X",
+	DICBCLineVoltage:                    "XXX.X",
+	DICCALineVoltage:                    "XXX.X",
+	DICLineVoltage:                      "XXX.X",
+	DICNeutralCurrent:                   "XXX.XXX",
+	DICFrequency:                        "XX.XX",
+	DICAverageActivePower:               "XX.XXXX",
+	DICActiveDemand:                     "XX.XXXX",
+	DICReactiveDemand:                   "XX.XXXX",
+	DICApparentDemand:                   "XX.XXXX",
+	DICTemperature:                      "XXX.X",
+	DICClockBatteryVoltage:              "XX.XX",
+	DICReadingBatteryVoltage:            "XX.XX",
+	DICBatteryRunTime:                   "XXXXXXXX",
+	DICCurrentTariffPrice:               "XXXX.XXXX",
+	DICTotalOverCurrentCount:            "XXXXXX, XXXXXX",
+	DICTotalPowerDownCount:              "XXXXXX",
+	DICPowerDownRecord:                  "",
+	DICTotalProgramCount:                "XXXXXX",
+	DICProgramRecord:                    "",
+	DICTotalMeterResetCount:             "XXXXXX",
+	DICMeterResetRecord:                 "",
+	DICTotalDemandResetCount:            "XXXXXX",
+	DICDemandResetRecord:                "",
+	DICTotalEventResetCount:             "XXXXXX",
+	DICEventResetRecord:                 "",
+	DICTotalClockAdjustCount:            "XXXXXX",
+	DICClockAdjustRecord:                "",
+	DICTotalMeterCoverOpenCount:         "XXXXXX",
+	DICMeterCoverOpenRecord:             "",
+	DICTotalTerminalCoverOpenCount:      "XXXXXX",
+	DICTerminalCoverOpenRecord:          "",
+	DICLastPurchaseTime:                 "YYMMDDhhmm",
+	DICLastPurchaseCount:                "XXXX",
+	DICLastPurchaseAmount:               "XXXXXX.XX",
+	DICLastPurchaseBalanceBefore:        "XXXXXX.XX",
+	DICLastPurchaseBalanceAfter:         "XXXXXX.XX",
+	DICLastTotalPurchaseAmount:          "XXXXXX.XX",
+	DICDateTime:                         "YYMMDDWW",
+	DICTime:                             "hhmmss",
+	DICDemandPeriod:                     "NN",
+	DICSlidingTime:                      "NN",
+	DICTimeZoneSwitchTime:               "YYMMDDhhmm",
+	DICDailyTableSwitchTime:             "YYMMDDhhmm",
+	DICTariffSwitchTime:                 "YYMMDDhhmm",
+	DICStepSwitchTime:                   "YYMMDDhhmm",
+	DICTimeZoneCount:                    "NN",
+	DICDailyTableCount:                  "NN",
+	DICPeriodCount:                      "NN",
+	DICTariffCount:                      "NN",
+	DICHolidayCount:                     "NNNN",
+	DICMeterNumber:                      "NNNNNNNNNNNN",
+	DICAssetManagementCode:              "N",
+	DICActiveConstant:                   "XXXXXX",
+	DICReactiveConstant:                 "XXXXXX",
+	DICUserNumber:                       "NNNNNNNNNNNN",
+	DICRunningStatusWord1:               "",
+	DICRunningStatusWord2:               "",
+	DICRunningStatusWord3:               "",
+	DICRunningStatusWord4:               "",
+	DICRunningStatusWord5:               "",
+	DICRunningStatusWord6:               "",
+	DICRunningStatusWord7:               "",
+	DICRunningStatusWord:                "",
+	DICAlarmAmount1Limit:                "XXXXXX.XX",
+	DICAlarmAmount2Limit:                "XXXXXX.XX",
+	DICOverdraftAmountLimit:             "XXXXXX.XX",
+	DICHoardingAmountLimit:              "XXXXXX.XX",
+	DICCloseAllowedAmountLimit:          "XXXXXX.XX",
+	DICActiveReportStatusWord:           "",
+	DICFirstTimeZoneTable:               "MMDDNN",
+	DICFirstDailyTable1:                 "hhmmNN",
+	DICSecondTimeZoneTable:              "MMDDNN",
+	DICSecondDailyTable1:                "hhmmNN",
+	DICHoliday1:                         "YYMMDDNN",
+	DICPositiveTotalReactiveEnergy:      "",
+	DICPositiveReactiveEnergyRate1:      "",
+	DICPositiveReactiveEnergyRate2:      "",
+	DICPositiveReactiveEnergyRate3:      "",
+	DICPositiveReactiveEnergyRate4:      "",
+	DICPositiveReactiveEnergy:           "",
+	DICNegativeTotalReactiveEnergy:      "",
+	DICNegativeReactiveEnergyRate1:      "",
+	DICNegativeReactiveEnergyRate2:      "",
+	DICNegativeReactiveEnergyRate3:      "",
+	DICNegativeReactiveEnergyRate4:      "",
+	DICNegativeReactiveEnergy:           "",
+	DICPositiveReactiveMaxDemand:        "",
+	DICNegativeReactiveMaxDemand:        "",
+	DICPositiveActiveMaxDemandTime:      "",
+	DICPositiveActiveMaxDemandRate1Time: "",
+	DICPositiveActiveMaxDemandRate2Time: "",
+	DICPositiveActiveMaxDemandRate3Time: "",
+	DICPositiveActiveMaxDemandRate4Time: "",
+	DICPositiveActiveMaxDemandTimeBlock: "",
+	DICNegativeActiveMaxDemandTime:      "",
+	DICPositiveReactiveMaxDemandTime:    "",
+	DICNegativeReactiveMaxDemandTime:    "",
+	DICLastProgramTime:                  "",
+	DICLastDemandResetTime:              "",
+	DICTotalPhaseBreakCount:             "",
+	DICPhaseABreakCount:                 "",
+	DICPhaseBBreakCount:                 "",
+	DICPhaseCBreakCount:                 "",
+	DICPhaseBreakCount:                  "",
+	DICTotalPhaseBreakTime:              "",
+	DICPhaseABreakTime:                  "",
+	DICPhaseBBreakTime:                  "",
+	DICPhaseCBreakTime:                  "",
+	DICPhaseBreakTime:                   "",
+	DICTotalPhaseLastBreakStartTime:     "",
+	DICPhaseALastBreakStartTime:         "",
+	DICPhaseBLastBreakStartTime:         "",
+	DICPhaseCLastBreakStartTime:         "",
+	DICPhaseLastBreakStartTime:          "",
+	DICTotalPhaseLastBreakEndTime:       "",
+	DICPhaseALastBreakEndTime:           "",
+	DICPhaseBLastBreakEndTime:           "",
+	DICPhaseCLastBreakEndTime:           "",
+	DICPhaseLastBreakEndTime:            "",
+	DICMeterStatusWord:                  "",
+	DICGridStatusWord:                   "",
 }
 
 // NewFormat is the attribute of DIC.
@@ -607,59 +2680,337 @@ func (x DIC) NewFormat() string {
 }
 
 var _DICMapNewSize = map[DIC]int{
-	DICTotalActiveEnergy:             4,
-	DICPositiveTotalActiveEnergy:     4,
-	DICNegativeTotalActiveEnergy:     4,
-	DICTotalReactiveEnergy1:          4,
-	DICTotalReactiveEnergy2:          4,
-	DICFirstQuadrantReactiveEnergy:   4,
-	DICSecondQuadrantReactiveEnergy:  4,
-	DICThirdQuadrantReactiveEnergy:   4,
-	DICFourthQuadrantReactiveEnergy:  4,
-	DICPositiveTotalApparentEnergy:   4,
-	DICNegativeTotalApparentEnergy:   4,
-	DICAssociatedTotalElectricEnergy: 4,
-	DICPhaseAVoltage:                 2,
-	DICPhaseBVoltage:                 2,
-	DICPhaseCVoltage:                 2,
-	DICVoltage:                       2,
-	DICPhaseACurrent:                 3,
-	DICPhaseBCurrent:                 3,
-	DICPhaseCCurrent:                 3,
-	DICCurrent:                       3,
-	DICTotalActivePower:              3,
-	DICPhaseAActivePower:             3,
-	DICPhaseBActivePower:             3,
-	DICPhaseCActivePower:             3,
-	DICActivePower:                   3,
-	DICTotalReactivePower:            3,
-	DICPhaseAReactivePower:           3,
-	DICPhaseBReactivePower:           3,
-	DICPhaseCReactivePower:           3,
-	DICReactivePower:                 3,
-	DICTotalApparentPower:            3,
-	DICPhaseAApparentPower:           3,
-	DICPhaseBApparentPower:           3,
-	DICPhaseCApparentPower:           3,
-	DICApparentPower:                 3,
-	DICTotalPowerFactor:              2,
-	DICPhaseAPowerFactor:             2,
-	DICPhaseBPowerFactor:             2,
-	DICPhaseCPowerFactor:             2,
-	DICPowerFactor:                   2,
-	DICABLineVoltage:                 2,
-	DICBCLineVoltage:                 2,
-	DICCALineVoltage:                 2,
-	DICLineVoltage:                   2,
-	DICFrequency:                     2,
-	DICTotalOverCurrentCount:         6,
-	DICTotalMeterResetCount:          3,
-	DICMeterResetRecord:              0,
-	DICDateTime:                      4,
-	DICTime:                          3,
-	DICAssetManagementCode:           32,
-	DICActiveConstant:                3,
-	DICReactiveConstant:              3,
+	DICTotalActiveEnergy:                4,
+	DICPositiveTotalActiveEnergy:        4,
+	DICPositiveActiveEnergyRate1:        4,
+	DICPositiveActiveEnergyRate2:        4,
+	DICPositiveActiveEnergyRate3:        4,
+	DICPositiveActiveEnergyRate4:        4,
+	DICPositiveActiveEnergy:             4,
+	DICNegativeTotalActiveEnergy:        4,
+	DICNegativeActiveEnergyRate1:        4,
+	DICNegativeActiveEnergyRate2:        4,
+	DICNegativeActiveEnergyRate3:        4,
+	DICNegativeActiveEnergyRate4:        4,
+	DICNegativeActiveEnergy:             4,
+	DICTotalReactiveEnergy1:             4,
+	DICReactiveEnergy1Rate1:             4,
+	DICReactiveEnergy1Rate2:             4,
+	DICReactiveEnergy1Rate3:             4,
+	DICReactiveEnergy1Rate4:             4,
+	DICReactiveEnergy1:                  4,
+	DICTotalReactiveEnergy2:             4,
+	DICReactiveEnergy2Rate1:             4,
+	DICReactiveEnergy2Rate2:             4,
+	DICReactiveEnergy2Rate3:             4,
+	DICReactiveEnergy2Rate4:             4,
+	DICReactiveEnergy2:                  4,
+	DICFirstQuadrantReactiveEnergy:      4,
+	DICSecondQuadrantReactiveEnergy:     4,
+	DICThirdQuadrantReactiveEnergy:      4,
+	DICFourthQuadrantReactiveEnergy:     4,
+	DICPositiveTotalApparentEnergy:      4,
+	DICNegativeTotalApparentEnergy:      4,
+	DICAssociatedTotalElectricEnergy:    4,
+	DICRemainingEnergy:                  4,
+	DICOverdraftEnergy:                  4,
+	DICRemainingAmount:                  4,
+	DICOverdraftAmount:                  4,
+	DICPositiveActiveMaxDemand:          8,
+	DICPositiveActiveMaxDemandRate1:     8,
+	DICPositiveActiveMaxDemandRate2:     8,
+	DICPositiveActiveMaxDemandRate3:     8,
+	DICPositiveActiveMaxDemandRate4:     8,
+	DICPositiveActiveMaxDemandBlock:     8,
+	DICNegativeActiveMaxDemand:          8,
+	DICReactiveMaxDemand1:               8,
+	DICReactiveMaxDemand2:               8,
+	DICPhaseAVoltage:                    2,
+	DICPhaseBVoltage:                    2,
+	DICPhaseCVoltage:                    2,
+	DICVoltage:                          2,
+	DICPhaseACurrent:                    3,
+	DICPhaseBCurrent:                    3,
+	DICPhaseCCurrent:                    3,
+	DICCurrent:                          3,
+	DICTotalActivePower:                 3,
+	DICPhaseAActivePower:                3,
+	DICPhaseBActivePower:                3,
+	DICPhaseCActivePower:                3,
+	DICActivePower:                      3,
+	DICTotalReactivePower:               3,
+	DICPhaseAReactivePower:              3,
+	DICPhaseBReactivePower:              3,
+	DICPhaseCReactivePower:              3,
+	DICReactivePower:                    3,
+	DICTotalApparentPower:               3,
+	DICPhaseAApparentPower:              3,
+	DICPhaseBApparentPower:              3,
+	DICPhaseCApparentPower:              3,
+	DICApparentPower:                    3,
+	DICTotalPowerFactor:                 2,
+	DICPhaseAPowerFactor:                2,
+	DICPhaseBPowerFactor:                2,
+	DICPhaseCPowerFactor:                2,
+	DICPowerFactor:                      2,
+	DICPhaseAAngle:                      2,
+	DICPhaseBAngle:                      2,
+	DICPhaseCAngle:                      2,
+	DICPhaseAngle:                       2,
+	DICPhaseAVoltageTHD:                 2,
+	DICPhaseAVoltageHarmonic2:           2,
+	DICPhaseAVoltageHarmonic3:           2,
+	DICPhaseAVoltageHarmonic4:           2,
+	DICPhaseAVoltageHarmonic5:           2,
+	DICPhaseAVoltageHarmonic6:           2,
+	DICPhaseAVoltageHarmonic7:           2,
+	DICPhaseAVoltageHarmonic8:           2,
+	DICPhaseAVoltageHarmonic9:           2,
+	DICPhaseAVoltageHarmonic10:          2,
+	DICPhaseAVoltageHarmonic11:          2,
+	DICPhaseAVoltageHarmonic12:          2,
+	DICPhaseAVoltageHarmonic13:          2,
+	DICPhaseAVoltageHarmonic14:          2,
+	DICPhaseAVoltageHarmonic15:          2,
+	DICPhaseAVoltageHarmonic16:          2,
+	DICPhaseAVoltageHarmonic17:          2,
+	DICPhaseAVoltageHarmonic18:          2,
+	DICPhaseAVoltageHarmonic19:          2,
+	DICPhaseAVoltageHarmonic20:          2,
+	DICPhaseAVoltageHarmonic21:          2,
+	DICPhaseAVoltageHarmonic:            2,
+	DICPhaseBVoltageTHD:                 2,
+	DICPhaseBVoltageHarmonic2:           2,
+	DICPhaseBVoltageHarmonic3:           2,
+	DICPhaseBVoltageHarmonic4:           2,
+	DICPhaseBVoltageHarmonic5:           2,
+	DICPhaseBVoltageHarmonic6:           2,
+	DICPhaseBVoltageHarmonic7:           2,
+	DICPhaseBVoltageHarmonic8:           2,
+	DICPhaseBVoltageHarmonic9:           2,
+	DICPhaseBVoltageHarmonic10:          2,
+	DICPhaseBVoltageHarmonic11:          2,
+	DICPhaseBVoltageHarmonic12:          2,
+	DICPhaseBVoltageHarmonic13:          2,
+	DICPhaseBVoltageHarmonic14:          2,
+	DICPhaseBVoltageHarmonic15:          2,
+	DICPhaseBVoltageHarmonic16:          2,
+	DICPhaseBVoltageHarmonic17:          2,
+	DICPhaseBVoltageHarmonic18:          2,
+	DICPhaseBVoltageHarmonic19:          2,
+	DICPhaseBVoltageHarmonic20:          2,
+	DICPhaseBVoltageHarmonic21:          2,
+	DICPhaseBVoltageHarmonic:            2,
+	DICPhaseCVoltageTHD:                 2,
+	DICPhaseCVoltageHarmonic2:           2,
+	DICPhaseCVoltageHarmonic3:           2,
+	DICPhaseCVoltageHarmonic4:           2,
+	DICPhaseCVoltageHarmonic5:           2,
+	DICPhaseCVoltageHarmonic6:           2,
+	DICPhaseCVoltageHarmonic7:           2,
+	DICPhaseCVoltageHarmonic8:           2,
+	DICPhaseCVoltageHarmonic9:           2,
+	DICPhaseCVoltageHarmonic10:          2,
+	DICPhaseCVoltageHarmonic11:          2,
+	DICPhaseCVoltageHarmonic12:          2,
+	DICPhaseCVoltageHarmonic13:          2,
+	DICPhaseCVoltageHarmonic14:          2,
+	DICPhaseCVoltageHarmonic15:          2,
+	DICPhaseCVoltageHarmonic16:          2,
+	DICPhaseCVoltageHarmonic17:          2,
+	DICPhaseCVoltageHarmonic18:          2,
+	DICPhaseCVoltageHarmonic19:          2,
+	DICPhaseCVoltageHarmonic20:          2,
+	DICPhaseCVoltageHarmonic21:          2,
+	DICPhaseCVoltageHarmonic:            2,
+	DICPhaseACurrentTHD:                 2,
+	DICPhaseACurrentHarmonic2:           2,
+	DICPhaseACurrentHarmonic3:           2,
+	DICPhaseACurrentHarmonic4:           2,
+	DICPhaseACurrentHarmonic5:           2,
+	DICPhaseACurrentHarmonic6:           2,
+	DICPhaseACurrentHarmonic7:           2,
+	DICPhaseACurrentHarmonic8:           2,
+	DICPhaseACurrentHarmonic9:           2,
+	DICPhaseACurrentHarmonic10:          2,
+	DICPhaseACurrentHarmonic11:          2,
+	DICPhaseACurrentHarmonic12:          2,
+	DICPhaseACurrentHarmonic13:          2,
+	DICPhaseACurrentHarmonic14:          2,
+	DICPhaseACurrentHarmonic15:          2,
+	DICPhaseACurrentHarmonic16:          2,
+	DICPhaseACurrentHarmonic17:          2,
+	DICPhaseACurrentHarmonic18:          2,
+	DICPhaseACurrentHarmonic19:          2,
+	DICPhaseACurrentHarmonic20:          2,
+	DICPhaseACurrentHarmonic21:          2,
+	DICPhaseACurrentHarmonic:            2,
+	DICPhaseBCurrentTHD:                 2,
+	DICPhaseBCurrentHarmonic2:           2,
+	DICPhaseBCurrentHarmonic3:           2,
+	DICPhaseBCurrentHarmonic4:           2,
+	DICPhaseBCurrentHarmonic5:           2,
+	DICPhaseBCurrentHarmonic6:           2,
+	DICPhaseBCurrentHarmonic7:           2,
+	DICPhaseBCurrentHarmonic8:           2,
+	DICPhaseBCurrentHarmonic9:           2,
+	DICPhaseBCurrentHarmonic10:          2,
+	DICPhaseBCurrentHarmonic11:          2,
+	DICPhaseBCurrentHarmonic12:          2,
+	DICPhaseBCurrentHarmonic13:          2,
+	DICPhaseBCurrentHarmonic14:          2,
+	DICPhaseBCurrentHarmonic15:          2,
+	DICPhaseBCurrentHarmonic16:          2,
+	DICPhaseBCurrentHarmonic17:          2,
+	DICPhaseBCurrentHarmonic18:          2,
+	DICPhaseBCurrentHarmonic19:          2,
+	DICPhaseBCurrentHarmonic20:          2,
+	DICPhaseBCurrentHarmonic21:          2,
+	DICPhaseBCurrentHarmonic:            2,
+	DICPhaseCCurrentTHD:                 2,
+	DICPhaseCCurrentHarmonic2:           2,
+	DICPhaseCCurrentHarmonic3:           2,
+	DICPhaseCCurrentHarmonic4:           2,
+	DICPhaseCCurrentHarmonic5:           2,
+	DICPhaseCCurrentHarmonic6:           2,
+	DICPhaseCCurrentHarmonic7:           2,
+	DICPhaseCCurrentHarmonic8:           2,
+	DICPhaseCCurrentHarmonic9:           2,
+	DICPhaseCCurrentHarmonic10:          2,
+	DICPhaseCCurrentHarmonic11:          2,
+	DICPhaseCCurrentHarmonic12:          2,
+	DICPhaseCCurrentHarmonic13:          2,
+	DICPhaseCCurrentHarmonic14:          2,
+	DICPhaseCCurrentHarmonic15:          2,
+	DICPhaseCCurrentHarmonic16:          2,
+	DICPhaseCCurrentHarmonic17:          2,
+	DICPhaseCCurrentHarmonic18:          2,
+	DICPhaseCCurrentHarmonic19:          2,
+	DICPhaseCCurrentHarmonic20:          2,
+	DICPhaseCCurrentHarmonic21:          2,
+	DICPhaseCCurrentHarmonic:            2,
+	DICABLineVoltage:                    2,
+	DICBCLineVoltage:                    2,
+	DICCALineVoltage:                    2,
+	DICLineVoltage:                      2,
+	DICNeutralCurrent:                   3,
+	DICFrequency:                        2,
+	DICAverageActivePower:               3,
+	DICActiveDemand:                     3,
+	DICReactiveDemand:                   3,
+	DICApparentDemand:                   3,
+	DICTemperature:                      2,
+	DICClockBatteryVoltage:              2,
+	DICReadingBatteryVoltage:            2,
+	DICBatteryRunTime:                   4,
+	DICCurrentTariffPrice:               4,
+	DICTotalOverCurrentCount:            6,
+	DICTotalPowerDownCount:              3,
+	DICPowerDownRecord:                  12,
+	DICTotalProgramCount:                3,
+	DICProgramRecord:                    50,
+	DICTotalMeterResetCount:             3,
+	DICMeterResetRecord:                 106,
+	DICTotalDemandResetCount:            3,
+	DICDemandResetRecord:                202,
+	DICTotalEventResetCount:             3,
+	DICEventResetRecord:                 14,
+	DICTotalClockAdjustCount:            3,
+	DICClockAdjustRecord:                16,
+	DICTotalMeterCoverOpenCount:         3,
+	DICMeterCoverOpenRecord:             60,
+	DICTotalTerminalCoverOpenCount:      3,
+	DICTerminalCoverOpenRecord:          60,
+	DICLastPurchaseTime:                 5,
+	DICLastPurchaseCount:                2,
+	DICLastPurchaseAmount:               4,
+	DICLastPurchaseBalanceBefore:        4,
+	DICLastPurchaseBalanceAfter:         4,
+	DICLastTotalPurchaseAmount:          4,
+	DICDateTime:                         4,
+	DICTime:                             3,
+	DICDemandPeriod:                     1,
+	DICSlidingTime:                      1,
+	DICTimeZoneSwitchTime:               5,
+	DICDailyTableSwitchTime:             5,
+	DICTariffSwitchTime:                 5,
+	DICStepSwitchTime:                   5,
+	DICTimeZoneCount:                    1,
+	DICDailyTableCount:                  1,
+	DICPeriodCount:                      1,
+	DICTariffCount:                      1,
+	DICHolidayCount:                     2,
+	DICMeterNumber:                      6,
+	DICAssetManagementCode:              32,
+	DICActiveConstant:                   3,
+	DICReactiveConstant:                 3,
+	DICUserNumber:                       6,
+	DICRunningStatusWord1:               2,
+	DICRunningStatusWord2:               2,
+	DICRunningStatusWord3:               2,
+	DICRunningStatusWord4:               2,
+	DICRunningStatusWord5:               2,
+	DICRunningStatusWord6:               2,
+	DICRunningStatusWord7:               2,
+	DICRunningStatusWord:                2,
+	DICAlarmAmount1Limit:                4,
+	DICAlarmAmount2Limit:                4,
+	DICOverdraftAmountLimit:             4,
+	DICHoardingAmountLimit:              4,
+	DICCloseAllowedAmountLimit:          4,
+	DICActiveReportStatusWord:           12,
+	DICFirstTimeZoneTable:               42,
+	DICFirstDailyTable1:                 42,
+	DICSecondTimeZoneTable:              42,
+	DICSecondDailyTable1:                42,
+	DICHoliday1:                         4,
+	DICPositiveTotalReactiveEnergy:      0,
+	DICPositiveReactiveEnergyRate1:      0,
+	DICPositiveReactiveEnergyRate2:      0,
+	DICPositiveReactiveEnergyRate3:      0,
+	DICPositiveReactiveEnergyRate4:      0,
+	DICPositiveReactiveEnergy:           0,
+	DICNegativeTotalReactiveEnergy:      0,
+	DICNegativeReactiveEnergyRate1:      0,
+	DICNegativeReactiveEnergyRate2:      0,
+	DICNegativeReactiveEnergyRate3:      0,
+	DICNegativeReactiveEnergyRate4:      0,
+	DICNegativeReactiveEnergy:           0,
+	DICPositiveReactiveMaxDemand:        0,
+	DICNegativeReactiveMaxDemand:        0,
+	DICPositiveActiveMaxDemandTime:      0,
+	DICPositiveActiveMaxDemandRate1Time: 0,
+	DICPositiveActiveMaxDemandRate2Time: 0,
+	DICPositiveActiveMaxDemandRate3Time: 0,
+	DICPositiveActiveMaxDemandRate4Time: 0,
+	DICPositiveActiveMaxDemandTimeBlock: 0,
+	DICNegativeActiveMaxDemandTime:      0,
+	DICPositiveReactiveMaxDemandTime:    0,
+	DICNegativeReactiveMaxDemandTime:    0,
+	DICLastProgramTime:                  0,
+	DICLastDemandResetTime:              0,
+	DICTotalPhaseBreakCount:             0,
+	DICPhaseABreakCount:                 0,
+	DICPhaseBBreakCount:                 0,
+	DICPhaseCBreakCount:                 0,
+	DICPhaseBreakCount:                  0,
+	DICTotalPhaseBreakTime:              0,
+	DICPhaseABreakTime:                  0,
+	DICPhaseBBreakTime:                  0,
+	DICPhaseCBreakTime:                  0,
+	DICPhaseBreakTime:                   0,
+	DICTotalPhaseLastBreakStartTime:     0,
+	DICPhaseALastBreakStartTime:         0,
+	DICPhaseBLastBreakStartTime:         0,
+	DICPhaseCLastBreakStartTime:         0,
+	DICPhaseLastBreakStartTime:          0,
+	DICTotalPhaseLastBreakEndTime:       0,
+	DICPhaseALastBreakEndTime:           0,
+	DICPhaseBLastBreakEndTime:           0,
+	DICPhaseCLastBreakEndTime:           0,
+	DICPhaseLastBreakEndTime:            0,
+	DICMeterStatusWord:                  0,
+	DICGridStatusWord:                   0,
 }
 
 // NewSize is the attribute of DIC.
@@ -671,59 +3022,337 @@ func (x DIC) NewSize() int {
 }
 
 var _DICMapUnit = map[DIC]string{
-	DICTotalActiveEnergy:             "kWh",
-	DICPositiveTotalActiveEnergy:     "kWh",
-	DICNegativeTotalActiveEnergy:     "kWh",
-	DICTotalReactiveEnergy1:          "kvarh",
-	DICTotalReactiveEnergy2:          "kvarh",
-	DICFirstQuadrantReactiveEnergy:   "kvarh",
-	DICSecondQuadrantReactiveEnergy:  "kvarh",
-	DICThirdQuadrantReactiveEnergy:   "kvarh",
-	DICFourthQuadrantReactiveEnergy:  "kvarh",
-	DICPositiveTotalApparentEnergy:   "KVAh",
-	DICNegativeTotalApparentEnergy:   "KVAh",
-	DICAssociatedTotalElectricEnergy: "KVh",
-	DICPhaseAVoltage:                 "V",
-	DICPhaseBVoltage:                 "V",
-	DICPhaseCVoltage:                 "V",
-	DICVoltage:                       "V",
-	DICPhaseACurrent:                 "A",
-	DICPhaseBCurrent:                 "A",
-	DICPhaseCCurrent:                 "A",
-	DICCurrent:                       "A",
-	DICTotalActivePower:              "kW",
-	DICPhaseAActivePower:             "kW",
-	DICPhaseBActivePower:             "kW",
-	DICPhaseCActivePower:             "kW",
-	DICActivePower:                   "kW",
-	DICTotalReactivePower:            "kvar",
-	DICPhaseAReactivePower:           "kvar",
-	DICPhaseBReactivePower:           "kvar",
-	DICPhaseCReactivePower:           "kvar",
-	DICReactivePower:                 "kvar",
-	DICTotalApparentPower:            "kVA",
-	DICPhaseAApparentPower:           "kVA",
-	DICPhaseBApparentPower:           "kVA",
-	DICPhaseCApparentPower:           "kVA",
-	DICApparentPower:                 "kVA",
-	DICTotalPowerFactor:              "",
-	DICPhaseAPowerFactor:             "",
-	DICPhaseBPowerFactor:             "",
-	DICPhaseCPowerFactor:             "",
-	DICPowerFactor:                   "",
-	DICABLineVoltage:                 "V",
-	DICBCLineVoltage:                 "V",
-	DICCALineVoltage:                 "V",
-	DICLineVoltage:                   "V",
-	DICFrequency:                     "Hz",
-	DICTotalOverCurrentCount:         "次,分",
-	DICTotalMeterResetCount:          "次",
-	DICMeterResetRecord:              "",
-	DICDateTime:                      "年月日星期",
-	DICTime:                          "时分秒",
-	DICAssetManagementCode:           "",
-	DICActiveConstant:                "imp/kWh",
-	DICReactiveConstant:              "imp/kvarh",
+	DICTotalActiveEnergy:                "kWh",
+	DICPositiveTotalActiveEnergy:        "kWh",
+	DICPositiveActiveEnergyRate1:        "kWh",
+	DICPositiveActiveEnergyRate2:        "kWh",
+	DICPositiveActiveEnergyRate3:        "kWh",
+	DICPositiveActiveEnergyRate4:        "kWh",
+	DICPositiveActiveEnergy:             "kWh",
+	DICNegativeTotalActiveEnergy:        "kWh",
+	DICNegativeActiveEnergyRate1:        "kWh",
+	DICNegativeActiveEnergyRate2:        "kWh",
+	DICNegativeActiveEnergyRate3:        "kWh",
+	DICNegativeActiveEnergyRate4:        "kWh",
+	DICNegativeActiveEnergy:             "kWh",
+	DICTotalReactiveEnergy1:             "kvarh",
+	DICReactiveEnergy1Rate1:             "kvarh",
+	DICReactiveEnergy1Rate2:             "kvarh",
+	DICReactiveEnergy1Rate3:             "kvarh",
+	DICReactiveEnergy1Rate4:             "kvarh",
+	DICReactiveEnergy1:                  "kvarh",
+	DICTotalReactiveEnergy2:             "kvarh",
+	DICReactiveEnergy2Rate1:             "kvarh",
+	DICReactiveEnergy2Rate2:             "kvarh",
+	DICReactiveEnergy2Rate3:             "kvarh",
+	DICReactiveEnergy2Rate4:             "kvarh",
+	DICReactiveEnergy2:                  "kvarh",
+	DICFirstQuadrantReactiveEnergy:      "kvarh",
+	DICSecondQuadrantReactiveEnergy:     "kvarh",
+	DICThirdQuadrantReactiveEnergy:      "kvarh",
+	DICFourthQuadrantReactiveEnergy:     "kvarh",
+	DICPositiveTotalApparentEnergy:      "KVAh",
+	DICNegativeTotalApparentEnergy:      "KVAh",
+	DICAssociatedTotalElectricEnergy:    "KVh",
+	DICRemainingEnergy:                  "kWh",
+	DICOverdraftEnergy:                  "kWh",
+	DICRemainingAmount:                  "元",
+	DICOverdraftAmount:                  "元",
+	DICPositiveActiveMaxDemand:          "kW",
+	DICPositiveActiveMaxDemandRate1:     "kW",
+	DICPositiveActiveMaxDemandRate2:     "kW",
+	DICPositiveActiveMaxDemandRate3:     "kW",
+	DICPositiveActiveMaxDemandRate4:     "kW",
+	DICPositiveActiveMaxDemandBlock:     "kW",
+	DICNegativeActiveMaxDemand:          "kW",
+	DICReactiveMaxDemand1:               "kvar",
+	DICReactiveMaxDemand2:               "kvar",
+	DICPhaseAVoltage:                    "V",
+	DICPhaseBVoltage:                    "V",
+	DICPhaseCVoltage:                    "V",
+	DICVoltage:                          "V",
+	DICPhaseACurrent:                    "A",
+	DICPhaseBCurrent:                    "A",
+	DICPhaseCCurrent:                    "A",
+	DICCurrent:                          "A",
+	DICTotalActivePower:                 "kW",
+	DICPhaseAActivePower:                "kW",
+	DICPhaseBActivePower:                "kW",
+	DICPhaseCActivePower:                "kW",
+	DICActivePower:                      "kW",
+	DICTotalReactivePower:               "kvar",
+	DICPhaseAReactivePower:              "kvar",
+	DICPhaseBReactivePower:              "kvar",
+	DICPhaseCReactivePower:              "kvar",
+	DICReactivePower:                    "kvar",
+	DICTotalApparentPower:               "kVA",
+	DICPhaseAApparentPower:              "kVA",
+	DICPhaseBApparentPower:              "kVA",
+	DICPhaseCApparentPower:              "kVA",
+	DICApparentPower:                    "kVA",
+	DICTotalPowerFactor:                 "",
+	DICPhaseAPowerFactor:                "",
+	DICPhaseBPowerFactor:                "",
+	DICPhaseCPowerFactor:                "",
+	DICPowerFactor:                      "",
+	DICPhaseAAngle:                      "°",
+	DICPhaseBAngle:                      "°",
+	DICPhaseCAngle:                      "°",
+	DICPhaseAngle:                       "°",
+	DICPhaseAVoltageTHD:                 "%",
+	DICPhaseAVoltageHarmonic2:           "%",
+	DICPhaseAVoltageHarmonic3:           "%",
+	DICPhaseAVoltageHarmonic4:           "%",
+	DICPhaseAVoltageHarmonic5:           "%",
+	DICPhaseAVoltageHarmonic6:           "%",
+	DICPhaseAVoltageHarmonic7:           "%",
+	DICPhaseAVoltageHarmonic8:           "%",
+	DICPhaseAVoltageHarmonic9:           "%",
+	DICPhaseAVoltageHarmonic10:          "%",
+	DICPhaseAVoltageHarmonic11:          "%",
+	DICPhaseAVoltageHarmonic12:          "%",
+	DICPhaseAVoltageHarmonic13:          "%",
+	DICPhaseAVoltageHarmonic14:          "%",
+	DICPhaseAVoltageHarmonic15:          "%",
+	DICPhaseAVoltageHarmonic16:          "%",
+	DICPhaseAVoltageHarmonic17:          "%",
+	DICPhaseAVoltageHarmonic18:          "%",
+	DICPhaseAVoltageHarmonic19:          "%",
+	DICPhaseAVoltageHarmonic20:          "%",
+	DICPhaseAVoltageHarmonic21:          "%",
+	DICPhaseAVoltageHarmonic:            "%",
+	DICPhaseBVoltageTHD:                 "%",
+	DICPhaseBVoltageHarmonic2:           "%",
+	DICPhaseBVoltageHarmonic3:           "%",
+	DICPhaseBVoltageHarmonic4:           "%",
+	DICPhaseBVoltageHarmonic5:           "%",
+	DICPhaseBVoltageHarmonic6:           "%",
+	DICPhaseBVoltageHarmonic7:           "%",
+	DICPhaseBVoltageHarmonic8:           "%",
+	DICPhaseBVoltageHarmonic9:           "%",
+	DICPhaseBVoltageHarmonic10:          "%",
+	DICPhaseBVoltageHarmonic11:          "%",
+	DICPhaseBVoltageHarmonic12:          "%",
+	DICPhaseBVoltageHarmonic13:          "%",
+	DICPhaseBVoltageHarmonic14:          "%",
+	DICPhaseBVoltageHarmonic15:          "%",
+	DICPhaseBVoltageHarmonic16:          "%",
+	DICPhaseBVoltageHarmonic17:          "%",
+	DICPhaseBVoltageHarmonic18:          "%",
+	DICPhaseBVoltageHarmonic19:          "%",
+	DICPhaseBVoltageHarmonic20:          "%",
+	DICPhaseBVoltageHarmonic21:          "%",
+	DICPhaseBVoltageHarmonic:            "%",
+	DICPhaseCVoltageTHD:                 "%",
+	DICPhaseCVoltageHarmonic2:           "%",
+	DICPhaseCVoltageHarmonic3:           "%",
+	DICPhaseCVoltageHarmonic4:           "%",
+	DICPhaseCVoltageHarmonic5:           "%",
+	DICPhaseCVoltageHarmonic6:           "%",
+	DICPhaseCVoltageHarmonic7:           "%",
+	DICPhaseCVoltageHarmonic8:           "%",
+	DICPhaseCVoltageHarmonic9:           "%",
+	DICPhaseCVoltageHarmonic10:          "%",
+	DICPhaseCVoltageHarmonic11:          "%",
+	DICPhaseCVoltageHarmonic12:          "%",
+	DICPhaseCVoltageHarmonic13:          "%",
+	DICPhaseCVoltageHarmonic14:          "%",
+	DICPhaseCVoltageHarmonic15:          "%",
+	DICPhaseCVoltageHarmonic16:          "%",
+	DICPhaseCVoltageHarmonic17:          "%",
+	DICPhaseCVoltageHarmonic18:          "%",
+	DICPhaseCVoltageHarmonic19:          "%",
+	DICPhaseCVoltageHarmonic20:          "%",
+	DICPhaseCVoltageHarmonic21:          "%",
+	DICPhaseCVoltageHarmonic:            "%",
+	DICPhaseACurrentTHD:                 "%",
+	DICPhaseACurrentHarmonic2:           "%",
+	DICPhaseACurrentHarmonic3:           "%",
+	DICPhaseACurrentHarmonic4:           "%",
+	DICPhaseACurrentHarmonic5:           "%",
+	DICPhaseACurrentHarmonic6:           "%",
+	DICPhaseACurrentHarmonic7:           "%",
+	DICPhaseACurrentHarmonic8:           "%",
+	DICPhaseACurrentHarmonic9:           "%",
+	DICPhaseACurrentHarmonic10:          "%",
+	DICPhaseACurrentHarmonic11:          "%",
+	DICPhaseACurrentHarmonic12:          "%",
+	DICPhaseACurrentHarmonic13:          "%",
+	DICPhaseACurrentHarmonic14:          "%",
+	DICPhaseACurrentHarmonic15:          "%",
+	DICPhaseACurrentHarmonic16:          "%",
+	DICPhaseACurrentHarmonic17:          "%",
+	DICPhaseACurrentHarmonic18:          "%",
+	DICPhaseACurrentHarmonic19:          "%",
+	DICPhaseACurrentHarmonic20:          "%",
+	DICPhaseACurrentHarmonic21:          "%",
+	DICPhaseACurrentHarmonic:            "%",
+	DICPhaseBCurrentTHD:                 "%",
+	DICPhaseBCurrentHarmonic2:           "%",
+	DICPhaseBCurrentHarmonic3:           "%",
+	DICPhaseBCurrentHarmonic4:           "%",
+	DICPhaseBCurrentHarmonic5:           "%",
+	DICPhaseBCurrentHarmonic6:           "%",
+	DICPhaseBCurrentHarmonic7:           "%",
+	DICPhaseBCurrentHarmonic8:           "%",
+	DICPhaseBCurrentHarmonic9:           "%",
+	DICPhaseBCurrentHarmonic10:          "%",
+	DICPhaseBCurrentHarmonic11:          "%",
+	DICPhaseBCurrentHarmonic12:          "%",
+	DICPhaseBCurrentHarmonic13:          "%",
+	DICPhaseBCurrentHarmonic14:          "%",
+	DICPhaseBCurrentHarmonic15:          "%",
+	DICPhaseBCurrentHarmonic16:          "%",
+	DICPhaseBCurrentHarmonic17:          "%",
+	DICPhaseBCurrentHarmonic18:          "%",
+	DICPhaseBCurrentHarmonic19:          "%",
+	DICPhaseBCurrentHarmonic20:          "%",
+	DICPhaseBCurrentHarmonic21:          "%",
+	DICPhaseBCurrentHarmonic:            "%",
+	DICPhaseCCurrentTHD:                 "%",
+	DICPhaseCCurrentHarmonic2:           "%",
+	DICPhaseCCurrentHarmonic3:           "%",
+	DICPhaseCCurrentHarmonic4:           "%",
+	DICPhaseCCurrentHarmonic5:           "%",
+	DICPhaseCCurrentHarmonic6:           "%",
+	DICPhaseCCurrentHarmonic7:           "%",
+	DICPhaseCCurrentHarmonic8:           "%",
+	DICPhaseCCurrentHarmonic9:           "%",
+	DICPhaseCCurrentHarmonic10:          "%",
+	DICPhaseCCurrentHarmonic11:          "%",
+	DICPhaseCCurrentHarmonic12:          "%",
+	DICPhaseCCurrentHarmonic13:          "%",
+	DICPhaseCCurrentHarmonic14:          "%",
+	DICPhaseCCurrentHarmonic15:          "%",
+	DICPhaseCCurrentHarmonic16:          "%",
+	DICPhaseCCurrentHarmonic17:          "%",
+	DICPhaseCCurrentHarmonic18:          "%",
+	DICPhaseCCurrentHarmonic19:          "%",
+	DICPhaseCCurrentHarmonic20:          "%",
+	DICPhaseCCurrentHarmonic21:          "%",
+	DICPhaseCCurrentHarmonic:            "%",
+	DICABLineVoltage:                    "V",
+	DICBCLineVoltage:                    "V",
+	DICCALineVoltage:                    "V",
+	DICLineVoltage:                      "V",
+	DICNeutralCurrent:                   "A",
+	DICFrequency:                        "Hz",
+	DICAverageActivePower:               "kW",
+	DICActiveDemand:                     "kW",
+	DICReactiveDemand:                   "kvar",
+	DICApparentDemand:                   "kVA",
+	DICTemperature:                      "℃",
+	DICClockBatteryVoltage:              "V",
+	DICReadingBatteryVoltage:            "V",
+	DICBatteryRunTime:                   "分",
+	DICCurrentTariffPrice:               "元/kWh",
+	DICTotalOverCurrentCount:            "次,分",
+	DICTotalPowerDownCount:              "次",
+	DICPowerDownRecord:                  "",
+	DICTotalProgramCount:                "次",
+	DICProgramRecord:                    "",
+	DICTotalMeterResetCount:             "次",
+	DICMeterResetRecord:                 "",
+	DICTotalDemandResetCount:            "次",
+	DICDemandResetRecord:                "",
+	DICTotalEventResetCount:             "次",
+	DICEventResetRecord:                 "",
+	DICTotalClockAdjustCount:            "次",
+	DICClockAdjustRecord:                "",
+	DICTotalMeterCoverOpenCount:         "次",
+	DICMeterCoverOpenRecord:             "",
+	DICTotalTerminalCoverOpenCount:      "次",
+	DICTerminalCoverOpenRecord:          "",
+	DICLastPurchaseTime:                 "年月日时分",
+	DICLastPurchaseCount:                "次",
+	DICLastPurchaseAmount:               "元",
+	DICLastPurchaseBalanceBefore:        "元",
+	DICLastPurchaseBalanceAfter:         "元",
+	DICLastTotalPurchaseAmount:          "元",
+	DICDateTime:                         "年月日星期",
+	DICTime:                             "时分秒",
+	DICDemandPeriod:                     "分",
+	DICSlidingTime:                      "分",
+	DICTimeZoneSwitchTime:               "年月日时分",
+	DICDailyTableSwitchTime:             "年月日时分",
+	DICTariffSwitchTime:                 "年月日时分",
+	DICStepSwitchTime:                   "年月日时分",
+	DICTimeZoneCount:                    "",
+	DICDailyTableCount:                  "",
+	DICPeriodCount:                      "",
+	DICTariffCount:                      "",
+	DICHolidayCount:                     "",
+	DICMeterNumber:                      "",
+	DICAssetManagementCode:              "",
+	DICActiveConstant:                   "imp/kWh",
+	DICReactiveConstant:                 "imp/kvarh",
+	DICUserNumber:                       "",
+	DICRunningStatusWord1:               "",
+	DICRunningStatusWord2:               "",
+	DICRunningStatusWord3:               "",
+	DICRunningStatusWord4:               "",
+	DICRunningStatusWord5:               "",
+	DICRunningStatusWord6:               "",
+	DICRunningStatusWord7:               "",
+	DICRunningStatusWord:                "",
+	DICAlarmAmount1Limit:                "元",
+	DICAlarmAmount2Limit:                "元",
+	DICOverdraftAmountLimit:             "元",
+	DICHoardingAmountLimit:              "元",
+	DICCloseAllowedAmountLimit:          "元",
+	DICActiveReportStatusWord:           "",
+	DICFirstTimeZoneTable:               "",
+	DICFirstDailyTable1:                 "",
+	DICSecondTimeZoneTable:              "",
+	DICSecondDailyTable1:                "",
+	DICHoliday1:                         "",
+	DICPositiveTotalReactiveEnergy:      "kvarh",
+	DICPositiveReactiveEnergyRate1:      "kvarh",
+	DICPositiveReactiveEnergyRate2:      "kvarh",
+	DICPositiveReactiveEnergyRate3:      "kvarh",
+	DICPositiveReactiveEnergyRate4:      "kvarh",
+	DICPositiveReactiveEnergy:           "kvarh",
+	DICNegativeTotalReactiveEnergy:      "kvarh",
+	DICNegativeReactiveEnergyRate1:      "kvarh",
+	DICNegativeReactiveEnergyRate2:      "kvarh",
+	DICNegativeReactiveEnergyRate3:      "kvarh",
+	DICNegativeReactiveEnergyRate4:      "kvarh",
+	DICNegativeReactiveEnergy:           "kvarh",
+	DICPositiveReactiveMaxDemand:        "kvar",
+	DICNegativeReactiveMaxDemand:        "kvar",
+	DICPositiveActiveMaxDemandTime:      "月日时分",
+	DICPositiveActiveMaxDemandRate1Time: "月日时分",
+	DICPositiveActiveMaxDemandRate2Time: "月日时分",
+	DICPositiveActiveMaxDemandRate3Time: "月日时分",
+	DICPositiveActiveMaxDemandRate4Time: "月日时分",
+	DICPositiveActiveMaxDemandTimeBlock: "月日时分",
+	DICNegativeActiveMaxDemandTime:      "月日时分",
+	DICPositiveReactiveMaxDemandTime:    "月日时分",
+	DICNegativeReactiveMaxDemandTime:    "月日时分",
+	DICLastProgramTime:                  "月日时分",
+	DICLastDemandResetTime:              "月日时分",
+	DICTotalPhaseBreakCount:             "次",
+	DICPhaseABreakCount:                 "次",
+	DICPhaseBBreakCount:                 "次",
+	DICPhaseCBreakCount:                 "次",
+	DICPhaseBreakCount:                  "次",
+	DICTotalPhaseBreakTime:              "分",
+	DICPhaseABreakTime:                  "分",
+	DICPhaseBBreakTime:                  "分",
+	DICPhaseCBreakTime:                  "分",
+	DICPhaseBreakTime:                   "分",
+	DICTotalPhaseLastBreakStartTime:     "月日时分",
+	DICPhaseALastBreakStartTime:         "月日时分",
+	DICPhaseBLastBreakStartTime:         "月日时分",
+	DICPhaseCLastBreakStartTime:         "月日时分",
+	DICPhaseLastBreakStartTime:          "月日时分",
+	DICTotalPhaseLastBreakEndTime:       "月日时分",
+	DICPhaseALastBreakEndTime:           "月日时分",
+	DICPhaseBLastBreakEndTime:           "月日时分",
+	DICPhaseCLastBreakEndTime:           "月日时分",
+	DICPhaseLastBreakEndTime:            "月日时分",
+	DICMeterStatusWord:                  "",
+	DICGridStatusWord:                   "",
 }
 
 // Unit is the attribute of DIC.
@@ -734,6 +3363,348 @@ func (x DIC) Unit() string {
 	return fmt.Sprintf("DIC(%d).Unit", x)
 }
 
+var _DICMapSigned = map[DIC]bool{
+	DICTotalActiveEnergy:                true,
+	DICPositiveTotalActiveEnergy:        false,
+	DICPositiveActiveEnergyRate1:        false,
+	DICPositiveActiveEnergyRate2:        false,
+	DICPositiveActiveEnergyRate3:        false,
+	DICPositiveActiveEnergyRate4:        false,
+	DICPositiveActiveEnergy:             false,
+	DICNegativeTotalActiveEnergy:        false,
+	DICNegativeActiveEnergyRate1:        false,
+	DICNegativeActiveEnergyRate2:        false,
+	DICNegativeActiveEnergyRate3:        false,
+	DICNegativeActiveEnergyRate4:        false,
+	DICNegativeActiveEnergy:             false,
+	DICTotalReactiveEnergy1:             true,
+	DICReactiveEnergy1Rate1:             true,
+	DICReactiveEnergy1Rate2:             true,
+	DICReactiveEnergy1Rate3:             true,
+	DICReactiveEnergy1Rate4:             true,
+	DICReactiveEnergy1:                  true,
+	DICTotalReactiveEnergy2:             true,
+	DICReactiveEnergy2Rate1:             true,
+	DICReactiveEnergy2Rate2:             true,
+	DICReactiveEnergy2Rate3:             true,
+	DICReactiveEnergy2Rate4:             true,
+	DICReactiveEnergy2:                  true,
+	DICFirstQuadrantReactiveEnergy:      false,
+	DICSecondQuadrantReactiveEnergy:     false,
+	DICThirdQuadrantReactiveEnergy:      false,
+	DICFourthQuadrantReactiveEnergy:     false,
+	DICPositiveTotalApparentEnergy:      false,
+	DICNegativeTotalApparentEnergy:      false,
+	DICAssociatedTotalElectricEnergy:    false,
+	DICRemainingEnergy:                  false,
+	DICOverdraftEnergy:                  false,
+	DICRemainingAmount:                  false,
+	DICOverdraftAmount:                  false,
+	DICPositiveActiveMaxDemand:          false,
+	DICPositiveActiveMaxDemandRate1:     false,
+	DICPositiveActiveMaxDemandRate2:     false,
+	DICPositiveActiveMaxDemandRate3:     false,
+	DICPositiveActiveMaxDemandRate4:     false,
+	DICPositiveActiveMaxDemandBlock:     false,
+	DICNegativeActiveMaxDemand:          false,
+	DICReactiveMaxDemand1:               true,
+	DICReactiveMaxDemand2:               true,
+	DICPhaseAVoltage:                    false,
+	DICPhaseBVoltage:                    false,
+	DICPhaseCVoltage:                    false,
+	DICVoltage:                          false,
+	DICPhaseACurrent:                    true,
+	DICPhaseBCurrent:                    true,
+	DICPhaseCCurrent:                    true,
+	DICCurrent:                          true,
+	DICTotalActivePower:                 true,
+	DICPhaseAActivePower:                true,
+	DICPhaseBActivePower:                true,
+	DICPhaseCActivePower:                true,
+	DICActivePower:                      true,
+	DICTotalReactivePower:               true,
+	DICPhaseAReactivePower:              true,
+	DICPhaseBReactivePower:              true,
+	DICPhaseCReactivePower:              true,
+	DICReactivePower:                    true,
+	DICTotalApparentPower:               false,
+	DICPhaseAApparentPower:              false,
+	DICPhaseBApparentPower:              false,
+	DICPhaseCApparentPower:              false,
+	DICApparentPower:                    false,
+	DICTotalPowerFactor:                 true,
+	DICPhaseAPowerFactor:                true,
+	DICPhaseBPowerFactor:                true,
+	DICPhaseCPowerFactor:                true,
+	DICPowerFactor:                      true,
+	DICPhaseAAngle:                      false,
+	DICPhaseBAngle:                      false,
+	DICPhaseCAngle:                      false,
+	DICPhaseAngle:                       false,
+	DICPhaseAVoltageTHD:                 false,
+	DICPhaseAVoltageHarmonic2:           false,
+	DICPhaseAVoltageHarmonic3:           false,
+	DICPhaseAVoltageHarmonic4:           false,
+	DICPhaseAVoltageHarmonic5:           false,
+	DICPhaseAVoltageHarmonic6:           false,
+	DICPhaseAVoltageHarmonic7:           false,
+	DICPhaseAVoltageHarmonic8:           false,
+	DICPhaseAVoltageHarmonic9:           false,
+	DICPhaseAVoltageHarmonic10:          false,
+	DICPhaseAVoltageHarmonic11:          false,
+	DICPhaseAVoltageHarmonic12:          false,
+	DICPhaseAVoltageHarmonic13:          false,
+	DICPhaseAVoltageHarmonic14:          false,
+	DICPhaseAVoltageHarmonic15:          false,
+	DICPhaseAVoltageHarmonic16:          false,
+	DICPhaseAVoltageHarmonic17:          false,
+	DICPhaseAVoltageHarmonic18:          false,
+	DICPhaseAVoltageHarmonic19:          false,
+	DICPhaseAVoltageHarmonic20:          false,
+	DICPhaseAVoltageHarmonic21:          false,
+	DICPhaseAVoltageHarmonic:            false,
+	DICPhaseBVoltageTHD:                 false,
+	DICPhaseBVoltageHarmonic2:           false,
+	DICPhaseBVoltageHarmonic3:           false,
+	DICPhaseBVoltageHarmonic4:           false,
+	DICPhaseBVoltageHarmonic5:           false,
+	DICPhaseBVoltageHarmonic6:           false,
+	DICPhaseBVoltageHarmonic7:           false,
+	DICPhaseBVoltageHarmonic8:           false,
+	DICPhaseBVoltageHarmonic9:           false,
+	DICPhaseBVoltageHarmonic10:          false,
+	DICPhaseBVoltageHarmonic11:          false,
+	DICPhaseBVoltageHarmonic12:          false,
+	DICPhaseBVoltageHarmonic13:          false,
+	DICPhaseBVoltageHarmonic14:          false,
+	DICPhaseBVoltageHarmonic15:          false,
+	DICPhaseBVoltageHarmonic16:          false,
+	DICPhaseBVoltageHarmonic17:          false,
+	DICPhaseBVoltageHarmonic18:          false,
+	DICPhaseBVoltageHarmonic19:          false,
+	DICPhaseBVoltageHarmonic20:          false,
+	DICPhaseBVoltageHarmonic21:          false,
+	DICPhaseBVoltageHarmonic:            false,
+	DICPhaseCVoltageTHD:                 false,
+	DICPhaseCVoltageHarmonic2:           false,
+	DICPhaseCVoltageHarmonic3:           false,
+	DICPhaseCVoltageHarmonic4:           false,
+	DICPhaseCVoltageHarmonic5:           false,
+	DICPhaseCVoltageHarmonic6:           false,
+	DICPhaseCVoltageHarmonic7:           false,
+	DICPhaseCVoltageHarmonic8:           false,
+	DICPhaseCVoltageHarmonic9:           false,
+	DICPhaseCVoltageHarmonic10:          false,
+	DICPhaseCVoltageHarmonic11:          false,
+	DICPhaseCVoltageHarmonic12:          false,
+	DICPhaseCVoltageHarmonic13:          false,
+	DICPhaseCVoltageHarmonic14:          false,
+	DICPhaseCVoltageHarmonic15:          false,
+	DICPhaseCVoltageHarmonic16:          false,
+	DICPhaseCVoltageHarmonic17:          false,
+	DICPhaseCVoltageHarmonic18:          false,
+	DICPhaseCVoltageHarmonic19:          false,
+	DICPhaseCVoltageHarmonic20:          false,
+	DICPhaseCVoltageHarmonic21:          false,
+	DICPhaseCVoltageHarmonic:            false,
+	DICPhaseACurrentTHD:                 false,
+	DICPhaseACurrentHarmonic2:           false,
+	DICPhaseACurrentHarmonic3:           false,
+	DICPhaseACurrentHarmonic4:           false,
+	DICPhaseACurrentHarmonic5:           false,
+	DICPhaseACurrentHarmonic6:           false,
+	DICPhaseACurrentHarmonic7:           false,
+	DICPhaseACurrentHarmonic8:           false,
+	DICPhaseACurrentHarmonic9:           false,
+	DICPhaseACurrentHarmonic10:          false,
+	DICPhaseACurrentHarmonic11:          false,
+	DICPhaseACurrentHarmonic12:          false,
+	DICPhaseACurrentHarmonic13:          false,
+	DICPhaseACurrentHarmonic14:          false,
+	DICPhaseACurrentHarmonic15:          false,
+	DICPhaseACurrentHarmonic16:          false,
+	DICPhaseACurrentHarmonic17:          false,
+	DICPhaseACurrentHarmonic18:          false,
+	DICPhaseACurrentHarmonic19:          false,
+	DICPhaseACurrentHarmonic20:          false,
+	DICPhaseACurrentHarmonic21:          false,
+	DICPhaseACurrentHarmonic:            false,
+	DICPhaseBCurrentTHD:                 false,
+	DICPhaseBCurrentHarmonic2:           false,
+	DICPhaseBCurrentHarmonic3:           false,
+	DICPhaseBCurrentHarmonic4:           false,
+	DICPhaseBCurrentHarmonic5:           false,
+	DICPhaseBCurrentHarmonic6:           false,
+	DICPhaseBCurrentHarmonic7:           false,
+	DICPhaseBCurrentHarmonic8:           false,
+	DICPhaseBCurrentHarmonic9:           false,
+	DICPhaseBCurrentHarmonic10:          false,
+	DICPhaseBCurrentHarmonic11:          false,
+	DICPhaseBCurrentHarmonic12:          false,
+	DICPhaseBCurrentHarmonic13:          false,
+	DICPhaseBCurrentHarmonic14:          false,
+	DICPhaseBCurrentHarmonic15:          false,
+	DICPhaseBCurrentHarmonic16:          false,
+	DICPhaseBCurrentHarmonic17:          false,
+	DICPhaseBCurrentHarmonic18:          false,
+	DICPhaseBCurrentHarmonic19:          false,
+	DICPhaseBCurrentHarmonic20:          false,
+	DICPhaseBCurrentHarmonic21:          false,
+	DICPhaseBCurrentHarmonic:            false,
+	DICPhaseCCurrentTHD:                 false,
+	DICPhaseCCurrentHarmonic2:           false,
+	DICPhaseCCurrentHarmonic3:           false,
+	DICPhaseCCurrentHarmonic4:           false,
+	DICPhaseCCurrentHarmonic5:           false,
+	DICPhaseCCurrentHarmonic6:           false,
+	DICPhaseCCurrentHarmonic7:           false,
+	DICPhaseCCurrentHarmonic8:           false,
+	DICPhaseCCurrentHarmonic9:           false,
+	DICPhaseCCurrentHarmonic10:          false,
+	DICPhaseCCurrentHarmonic11:          false,
+	DICPhaseCCurrentHarmonic12:          false,
+	DICPhaseCCurrentHarmonic13:          false,
+	DICPhaseCCurrentHarmonic14:          false,
+	DICPhaseCCurrentHarmonic15:          false,
+	DICPhaseCCurrentHarmonic16:          false,
+	DICPhaseCCurrentHarmonic17:          false,
+	DICPhaseCCurrentHarmonic18:          false,
+	DICPhaseCCurrentHarmonic19:          false,
+	DICPhaseCCurrentHarmonic20:          false,
+	DICPhaseCCurrentHarmonic21:          false,
+	DICPhaseCCurrentHarmonic:            false,
+	DICABLineVoltage:                    false,
+	DICBCLineVoltage:                    false,
+	DICCALineVoltage:                    false,
+	DICLineVoltage:                      false,
+	DICNeutralCurrent:                   true,
+	DICFrequency:                        false,
+	DICAverageActivePower:               true,
+	DICActiveDemand:                     true,
+	DICReactiveDemand:                   true,
+	DICApparentDemand:                   false,
+	DICTemperature:                      true,
+	DICClockBatteryVoltage:              false,
+	DICReadingBatteryVoltage:            false,
+	DICBatteryRunTime:                   false,
+	DICCurrentTariffPrice:               false,
+	DICTotalOverCurrentCount:            false,
+	DICTotalPowerDownCount:              false,
+	DICPowerDownRecord:                  false,
+	DICTotalProgramCount:                false,
+	DICProgramRecord:                    false,
+	DICTotalMeterResetCount:             false,
+	DICMeterResetRecord:                 false,
+	DICTotalDemandResetCount:            false,
+	DICDemandResetRecord:                false,
+	DICTotalEventResetCount:             false,
+	DICEventResetRecord:                 false,
+	DICTotalClockAdjustCount:            false,
+	DICClockAdjustRecord:                false,
+	DICTotalMeterCoverOpenCount:         false,
+	DICMeterCoverOpenRecord:             false,
+	DICTotalTerminalCoverOpenCount:      false,
+	DICTerminalCoverOpenRecord:          false,
+	DICLastPurchaseTime:                 false,
+	DICLastPurchaseCount:                false,
+	DICLastPurchaseAmount:               false,
+	DICLastPurchaseBalanceBefore:        false,
+	DICLastPurchaseBalanceAfter:         false,
+	DICLastTotalPurchaseAmount:          false,
+	DICDateTime:                         false,
+	DICTime:                             false,
+	DICDemandPeriod:                     false,
+	DICSlidingTime:                      false,
+	DICTimeZoneSwitchTime:               false,
+	DICDailyTableSwitchTime:             false,
+	DICTariffSwitchTime:                 false,
+	DICStepSwitchTime:                   false,
+	DICTimeZoneCount:                    false,
+	DICDailyTableCount:                  false,
+	DICPeriodCount:                      false,
+	DICTariffCount:                      false,
+	DICHolidayCount:                     false,
+	DICMeterNumber:                      false,
+	DICAssetManagementCode:              false,
+	DICActiveConstant:                   false,
+	DICReactiveConstant:                 false,
+	DICUserNumber:                       false,
+	DICRunningStatusWord1:               false,
+	DICRunningStatusWord2:               false,
+	DICRunningStatusWord3:               false,
+	DICRunningStatusWord4:               false,
+	DICRunningStatusWord5:               false,
+	DICRunningStatusWord6:               false,
+	DICRunningStatusWord7:               false,
+	DICRunningStatusWord:                false,
+	DICAlarmAmount1Limit:                false,
+	DICAlarmAmount2Limit:                false,
+	DICOverdraftAmountLimit:             false,
+	DICHoardingAmountLimit:              false,
+	DICCloseAllowedAmountLimit:          false,
+	DICActiveReportStatusWord:           false,
+	DICFirstTimeZoneTable:               false,
+	DICFirstDailyTable1:                 false,
+	DICSecondTimeZoneTable:              false,
+	DICSecondDailyTable1:                false,
+	DICHoliday1:                         false,
+	DICPositiveTotalReactiveEnergy:      false,
+	DICPositiveReactiveEnergyRate1:      false,
+	DICPositiveReactiveEnergyRate2:      false,
+	DICPositiveReactiveEnergyRate3:      false,
+	DICPositiveReactiveEnergyRate4:      false,
+	DICPositiveReactiveEnergy:           false,
+	DICNegativeTotalReactiveEnergy:      false,
+	DICNegativeReactiveEnergyRate1:      false,
+	DICNegativeReactiveEnergyRate2:      false,
+	DICNegativeReactiveEnergyRate3:      false,
+	DICNegativeReactiveEnergyRate4:      false,
+	DICNegativeReactiveEnergy:           false,
+	DICPositiveReactiveMaxDemand:        false,
+	DICNegativeReactiveMaxDemand:        false,
+	DICPositiveActiveMaxDemandTime:      false,
+	DICPositiveActiveMaxDemandRate1Time: false,
+	DICPositiveActiveMaxDemandRate2Time: false,
+	DICPositiveActiveMaxDemandRate3Time: false,
+	DICPositiveActiveMaxDemandRate4Time: false,
+	DICPositiveActiveMaxDemandTimeBlock: false,
+	DICNegativeActiveMaxDemandTime:      false,
+	DICPositiveReactiveMaxDemandTime:    false,
+	DICNegativeReactiveMaxDemandTime:    false,
+	DICLastProgramTime:                  false,
+	DICLastDemandResetTime:              false,
+	DICTotalPhaseBreakCount:             false,
+	DICPhaseABreakCount:                 false,
+	DICPhaseBBreakCount:                 false,
+	DICPhaseCBreakCount:                 false,
+	DICPhaseBreakCount:                  false,
+	DICTotalPhaseBreakTime:              false,
+	DICPhaseABreakTime:                  false,
+	DICPhaseBBreakTime:                  false,
+	DICPhaseCBreakTime:                  false,
+	DICPhaseBreakTime:                   false,
+	DICTotalPhaseLastBreakStartTime:     false,
+	DICPhaseALastBreakStartTime:         false,
+	DICPhaseBLastBreakStartTime:         false,
+	DICPhaseCLastBreakStartTime:         false,
+	DICPhaseLastBreakStartTime:          false,
+	DICTotalPhaseLastBreakEndTime:       false,
+	DICPhaseALastBreakEndTime:           false,
+	DICPhaseBLastBreakEndTime:           false,
+	DICPhaseCLastBreakEndTime:           false,
+	DICPhaseLastBreakEndTime:            false,
+	DICMeterStatusWord:                  false,
+	DICGridStatusWord:                   false,
+}
+
+// Signed is the attribute of DIC.
+func (x DIC) Signed() bool {
+	if v, ok := _DICMapSigned[x]; ok {
+		return v
+	}
+	return false
+}
+
 // Val is the attribute of DIC.
 func (x DIC) Val() uint32 {
 	return uint32(x)
@@ -742,9 +3713,29 @@ func (x DIC) Val() uint32 {
 var _DICValues = []DIC{
 	DICTotalActiveEnergy,
 	DICPositiveTotalActiveEnergy,
+	DICPositiveActiveEnergyRate1,
+	DICPositiveActiveEnergyRate2,
+	DICPositiveActiveEnergyRate3,
+	DICPositiveActiveEnergyRate4,
+	DICPositiveActiveEnergy,
 	DICNegativeTotalActiveEnergy,
+	DICNegativeActiveEnergyRate1,
+	DICNegativeActiveEnergyRate2,
+	DICNegativeActiveEnergyRate3,
+	DICNegativeActiveEnergyRate4,
+	DICNegativeActiveEnergy,
 	DICTotalReactiveEnergy1,
+	DICReactiveEnergy1Rate1,
+	DICReactiveEnergy1Rate2,
+	DICReactiveEnergy1Rate3,
+	DICReactiveEnergy1Rate4,
+	DICReactiveEnergy1,
 	DICTotalReactiveEnergy2,
+	DICReactiveEnergy2Rate1,
+	DICReactiveEnergy2Rate2,
+	DICReactiveEnergy2Rate3,
+	DICReactiveEnergy2Rate4,
+	DICReactiveEnergy2,
 	DICFirstQuadrantReactiveEnergy,
 	DICSecondQuadrantReactiveEnergy,
 	DICThirdQuadrantReactiveEnergy,
@@ -752,6 +3743,19 @@ var _DICValues = []DIC{
 	DICPositiveTotalApparentEnergy,
 	DICNegativeTotalApparentEnergy,
 	DICAssociatedTotalElectricEnergy,
+	DICRemainingEnergy,
+	DICOverdraftEnergy,
+	DICRemainingAmount,
+	DICOverdraftAmount,
+	DICPositiveActiveMaxDemand,
+	DICPositiveActiveMaxDemandRate1,
+	DICPositiveActiveMaxDemandRate2,
+	DICPositiveActiveMaxDemandRate3,
+	DICPositiveActiveMaxDemandRate4,
+	DICPositiveActiveMaxDemandBlock,
+	DICNegativeActiveMaxDemand,
+	DICReactiveMaxDemand1,
+	DICReactiveMaxDemand2,
 	DICPhaseAVoltage,
 	DICPhaseBVoltage,
 	DICPhaseCVoltage,
@@ -780,19 +3784,264 @@ var _DICValues = []DIC{
 	DICPhaseBPowerFactor,
 	DICPhaseCPowerFactor,
 	DICPowerFactor,
+	DICPhaseAAngle,
+	DICPhaseBAngle,
+	DICPhaseCAngle,
+	DICPhaseAngle,
+	DICPhaseAVoltageTHD,
+	DICPhaseAVoltageHarmonic2,
+	DICPhaseAVoltageHarmonic3,
+	DICPhaseAVoltageHarmonic4,
+	DICPhaseAVoltageHarmonic5,
+	DICPhaseAVoltageHarmonic6,
+	DICPhaseAVoltageHarmonic7,
+	DICPhaseAVoltageHarmonic8,
+	DICPhaseAVoltageHarmonic9,
+	DICPhaseAVoltageHarmonic10,
+	DICPhaseAVoltageHarmonic11,
+	DICPhaseAVoltageHarmonic12,
+	DICPhaseAVoltageHarmonic13,
+	DICPhaseAVoltageHarmonic14,
+	DICPhaseAVoltageHarmonic15,
+	DICPhaseAVoltageHarmonic16,
+	DICPhaseAVoltageHarmonic17,
+	DICPhaseAVoltageHarmonic18,
+	DICPhaseAVoltageHarmonic19,
+	DICPhaseAVoltageHarmonic20,
+	DICPhaseAVoltageHarmonic21,
+	DICPhaseAVoltageHarmonic,
+	DICPhaseBVoltageTHD,
+	DICPhaseBVoltageHarmonic2,
+	DICPhaseBVoltageHarmonic3,
+	DICPhaseBVoltageHarmonic4,
+	DICPhaseBVoltageHarmonic5,
+	DICPhaseBVoltageHarmonic6,
+	DICPhaseBVoltageHarmonic7,
+	DICPhaseBVoltageHarmonic8,
+	DICPhaseBVoltageHarmonic9,
+	DICPhaseBVoltageHarmonic10,
+	DICPhaseBVoltageHarmonic11,
+	DICPhaseBVoltageHarmonic12,
+	DICPhaseBVoltageHarmonic13,
+	DICPhaseBVoltageHarmonic14,
+	DICPhaseBVoltageHarmonic15,
+	DICPhaseBVoltageHarmonic16,
+	DICPhaseBVoltageHarmonic17,
+	DICPhaseBVoltageHarmonic18,
+	DICPhaseBVoltageHarmonic19,
+	DICPhaseBVoltageHarmonic20,
+	DICPhaseBVoltageHarmonic21,
+	DICPhaseBVoltageHarmonic,
+	DICPhaseCVoltageTHD,
+	DICPhaseCVoltageHarmonic2,
+	DICPhaseCVoltageHarmonic3,
+	DICPhaseCVoltageHarmonic4,
+	DICPhaseCVoltageHarmonic5,
+	DICPhaseCVoltageHarmonic6,
+	DICPhaseCVoltageHarmonic7,
+	DICPhaseCVoltageHarmonic8,
+	DICPhaseCVoltageHarmonic9,
+	DICPhaseCVoltageHarmonic10,
+	DICPhaseCVoltageHarmonic11,
+	DICPhaseCVoltageHarmonic12,
+	DICPhaseCVoltageHarmonic13,
+	DICPhaseCVoltageHarmonic14,
+	DICPhaseCVoltageHarmonic15,
+	DICPhaseCVoltageHarmonic16,
+	DICPhaseCVoltageHarmonic17,
+	DICPhaseCVoltageHarmonic18,
+	DICPhaseCVoltageHarmonic19,
+	DICPhaseCVoltageHarmonic20,
+	DICPhaseCVoltageHarmonic21,
+	DICPhaseCVoltageHarmonic,
+	DICPhaseACurrentTHD,
+	DICPhaseACurrentHarmonic2,
+	DICPhaseACurrentHarmonic3,
+	DICPhaseACurrentHarmonic4,
+	DICPhaseACurrentHarmonic5,
+	DICPhaseACurrentHarmonic6,
+	DICPhaseACurrentHarmonic7,
+	DICPhaseACurrentHarmonic8,
+	DICPhaseACurrentHarmonic9,
+	DICPhaseACurrentHarmonic10,
+	DICPhaseACurrentHarmonic11,
+	DICPhaseACurrentHarmonic12,
+	DICPhaseACurrentHarmonic13,
+	DICPhaseACurrentHarmonic14,
+	DICPhaseACurrentHarmonic15,
+	DICPhaseACurrentHarmonic16,
+	DICPhaseACurrentHarmonic17,
+	DICPhaseACurrentHarmonic18,
+	DICPhaseACurrentHarmonic19,
+	DICPhaseACurrentHarmonic20,
+	DICPhaseACurrentHarmonic21,
+	DICPhaseACurrentHarmonic,
+	DICPhaseBCurrentTHD,
+	DICPhaseBCurrentHarmonic2,
+	DICPhaseBCurrentHarmonic3,
+	DICPhaseBCurrentHarmonic4,
+	DICPhaseBCurrentHarmonic5,
+	DICPhaseBCurrentHarmonic6,
+	DICPhaseBCurrentHarmonic7,
+	DICPhaseBCurrentHarmonic8,
+	DICPhaseBCurrentHarmonic9,
+	DICPhaseBCurrentHarmonic10,
+	DICPhaseBCurrentHarmonic11,
+	DICPhaseBCurrentHarmonic12,
+	DICPhaseBCurrentHarmonic13,
+	DICPhaseBCurrentHarmonic14,
+	DICPhaseBCurrentHarmonic15,
+	DICPhaseBCurrentHarmonic16,
+	DICPhaseBCurrentHarmonic17,
+	DICPhaseBCurrentHarmonic18,
+	DICPhaseBCurrentHarmonic19,
+	DICPhaseBCurrentHarmonic20,
+	DICPhaseBCurrentHarmonic21,
+	DICPhaseBCurrentHarmonic,
+	DICPhaseCCurrentTHD,
+	DICPhaseCCurrentHarmonic2,
+	DICPhaseCCurrentHarmonic3,
+	DICPhaseCCurrentHarmonic4,
+	DICPhaseCCurrentHarmonic5,
+	DICPhaseCCurrentHarmonic6,
+	DICPhaseCCurrentHarmonic7,
+	DICPhaseCCurrentHarmonic8,
+	DICPhaseCCurrentHarmonic9,
+	DICPhaseCCurrentHarmonic10,
+	DICPhaseCCurrentHarmonic11,
+	DICPhaseCCurrentHarmonic12,
+	DICPhaseCCurrentHarmonic13,
+	DICPhaseCCurrentHarmonic14,
+	DICPhaseCCurrentHarmonic15,
+	DICPhaseCCurrentHarmonic16,
+	DICPhaseCCurrentHarmonic17,
+	DICPhaseCCurrentHarmonic18,
+	DICPhaseCCurrentHarmonic19,
+	DICPhaseCCurrentHarmonic20,
+	DICPhaseCCurrentHarmonic21,
+	DICPhaseCCurrentHarmonic,
 	DICABLineVoltage,
 	DICBCLineVoltage,
 	DICCALineVoltage,
 	DICLineVoltage,
+	DICNeutralCurrent,
 	DICFrequency,
+	DICAverageActivePower,
+	DICActiveDemand,
+	DICReactiveDemand,
+	DICApparentDemand,
+	DICTemperature,
+	DICClockBatteryVoltage,
+	DICReadingBatteryVoltage,
+	DICBatteryRunTime,
+	DICCurrentTariffPrice,
 	DICTotalOverCurrentCount,
+	DICTotalPowerDownCount,
+	DICPowerDownRecord,
+	DICTotalProgramCount,
+	DICProgramRecord,
 	DICTotalMeterResetCount,
 	DICMeterResetRecord,
+	DICTotalDemandResetCount,
+	DICDemandResetRecord,
+	DICTotalEventResetCount,
+	DICEventResetRecord,
+	DICTotalClockAdjustCount,
+	DICClockAdjustRecord,
+	DICTotalMeterCoverOpenCount,
+	DICMeterCoverOpenRecord,
+	DICTotalTerminalCoverOpenCount,
+	DICTerminalCoverOpenRecord,
+	DICLastPurchaseTime,
+	DICLastPurchaseCount,
+	DICLastPurchaseAmount,
+	DICLastPurchaseBalanceBefore,
+	DICLastPurchaseBalanceAfter,
+	DICLastTotalPurchaseAmount,
 	DICDateTime,
 	DICTime,
+	DICDemandPeriod,
+	DICSlidingTime,
+	DICTimeZoneSwitchTime,
+	DICDailyTableSwitchTime,
+	DICTariffSwitchTime,
+	DICStepSwitchTime,
+	DICTimeZoneCount,
+	DICDailyTableCount,
+	DICPeriodCount,
+	DICTariffCount,
+	DICHolidayCount,
+	DICMeterNumber,
 	DICAssetManagementCode,
 	DICActiveConstant,
 	DICReactiveConstant,
+	DICUserNumber,
+	DICRunningStatusWord1,
+	DICRunningStatusWord2,
+	DICRunningStatusWord3,
+	DICRunningStatusWord4,
+	DICRunningStatusWord5,
+	DICRunningStatusWord6,
+	DICRunningStatusWord7,
+	DICRunningStatusWord,
+	DICAlarmAmount1Limit,
+	DICAlarmAmount2Limit,
+	DICOverdraftAmountLimit,
+	DICHoardingAmountLimit,
+	DICCloseAllowedAmountLimit,
+	DICActiveReportStatusWord,
+	DICFirstTimeZoneTable,
+	DICFirstDailyTable1,
+	DICSecondTimeZoneTable,
+	DICSecondDailyTable1,
+	DICHoliday1,
+	DICPositiveTotalReactiveEnergy,
+	DICPositiveReactiveEnergyRate1,
+	DICPositiveReactiveEnergyRate2,
+	DICPositiveReactiveEnergyRate3,
+	DICPositiveReactiveEnergyRate4,
+	DICPositiveReactiveEnergy,
+	DICNegativeTotalReactiveEnergy,
+	DICNegativeReactiveEnergyRate1,
+	DICNegativeReactiveEnergyRate2,
+	DICNegativeReactiveEnergyRate3,
+	DICNegativeReactiveEnergyRate4,
+	DICNegativeReactiveEnergy,
+	DICPositiveReactiveMaxDemand,
+	DICNegativeReactiveMaxDemand,
+	DICPositiveActiveMaxDemandTime,
+	DICPositiveActiveMaxDemandRate1Time,
+	DICPositiveActiveMaxDemandRate2Time,
+	DICPositiveActiveMaxDemandRate3Time,
+	DICPositiveActiveMaxDemandRate4Time,
+	DICPositiveActiveMaxDemandTimeBlock,
+	DICNegativeActiveMaxDemandTime,
+	DICPositiveReactiveMaxDemandTime,
+	DICNegativeReactiveMaxDemandTime,
+	DICLastProgramTime,
+	DICLastDemandResetTime,
+	DICTotalPhaseBreakCount,
+	DICPhaseABreakCount,
+	DICPhaseBBreakCount,
+	DICPhaseCBreakCount,
+	DICPhaseBreakCount,
+	DICTotalPhaseBreakTime,
+	DICPhaseABreakTime,
+	DICPhaseBBreakTime,
+	DICPhaseCBreakTime,
+	DICPhaseBreakTime,
+	DICTotalPhaseLastBreakStartTime,
+	DICPhaseALastBreakStartTime,
+	DICPhaseBLastBreakStartTime,
+	DICPhaseCLastBreakStartTime,
+	DICPhaseLastBreakStartTime,
+	DICTotalPhaseLastBreakEndTime,
+	DICPhaseALastBreakEndTime,
+	DICPhaseBLastBreakEndTime,
+	DICPhaseCLastBreakEndTime,
+	DICPhaseLastBreakEndTime,
+	DICMeterStatusWord,
+	DICGridStatusWord,
 }
 
 // DICValues returns a list of the values of DIC
@@ -813,112 +4062,668 @@ func (x DIC) String() string {
 }
 
 var _DICNameMap = map[string]DIC{
-	_DICName[0:17]:                     DICTotalActiveEnergy,
-	strings.ToLower(_DICName[0:17]):    DICTotalActiveEnergy,
-	_DICName[17:42]:                    DICPositiveTotalActiveEnergy,
-	strings.ToLower(_DICName[17:42]):   DICPositiveTotalActiveEnergy,
-	_DICName[42:67]:                    DICNegativeTotalActiveEnergy,
-	strings.ToLower(_DICName[42:67]):   DICNegativeTotalActiveEnergy,
-	_DICName[67:87]:                    DICTotalReactiveEnergy1,
-	strings.ToLower(_DICName[67:87]):   DICTotalReactiveEnergy1,
-	_DICName[87:107]:                   DICTotalReactiveEnergy2,
-	strings.ToLower(_DICName[87:107]):  DICTotalReactiveEnergy2,
-	_DICName[107:134]:                  DICFirstQuadrantReactiveEnergy,
-	strings.ToLower(_DICName[107:134]): DICFirstQuadrantReactiveEnergy,
-	_DICName[134:162]:                  DICSecondQuadrantReactiveEnergy,
-	strings.ToLower(_DICName[134:162]): DICSecondQuadrantReactiveEnergy,
-	_DICName[162:189]:                  DICThirdQuadrantReactiveEnergy,
-	strings.ToLower(_DICName[162:189]): DICThirdQuadrantReactiveEnergy,
-	_DICName[189:217]:                  DICFourthQuadrantReactiveEnergy,
-	strings.ToLower(_DICName[189:217]): DICFourthQuadrantReactiveEnergy,
-	_DICName[217:244]:                  DICPositiveTotalApparentEnergy,
-	strings.ToLower(_DICName[217:244]): DICPositiveTotalApparentEnergy,
-	_DICName[244:271]:                  DICNegativeTotalApparentEnergy,
-	strings.ToLower(_DICName[244:271]): DICNegativeTotalApparentEnergy,
-	_DICName[271:300]:                  DICAssociatedTotalElectricEnergy,
-	strings.ToLower(_DICName[271:300]): DICAssociatedTotalElectricEnergy,
-	_DICName[300:313]:                  DICPhaseAVoltage,
-	strings.ToLower(_DICName[300:313]): DICPhaseAVoltage,
-	_DICName[313:326]:                  DICPhaseBVoltage,
-	strings.ToLower(_DICName[313:326]): DICPhaseBVoltage,
-	_DICName[326:339]:                  DICPhaseCVoltage,
-	strings.ToLower(_DICName[326:339]): DICPhaseCVoltage,
-	_DICName[339:346]:                  DICVoltage,
-	strings.ToLower(_DICName[339:346]): DICVoltage,
-	_DICName[346:359]:                  DICPhaseACurrent,
-	strings.ToLower(_DICName[346:359]): DICPhaseACurrent,
-	_DICName[359:372]:                  DICPhaseBCurrent,
-	strings.ToLower(_DICName[359:372]): DICPhaseBCurrent,
-	_DICName[372:385]:                  DICPhaseCCurrent,
-	strings.ToLower(_DICName[372:385]): DICPhaseCCurrent,
-	_DICName[385:392]:                  DICCurrent,
-	strings.ToLower(_DICName[385:392]): DICCurrent,
-	_DICName[392:408]:                  DICTotalActivePower,
-	strings.ToLower(_DICName[392:408]): DICTotalActivePower,
-	_DICName[408:425]:                  DICPhaseAActivePower,
-	strings.ToLower(_DICName[408:425]): DICPhaseAActivePower,
-	_DICName[425:442]:                  DICPhaseBActivePower,
-	strings.ToLower(_DICName[425:442]): DICPhaseBActivePower,
-	_DICName[442:459]:                  DICPhaseCActivePower,
-	strings.ToLower(_DICName[442:459]): DICPhaseCActivePower,
-	_DICName[459:470]:                  DICActivePower,
-	strings.ToLower(_DICName[459:470]): DICActivePower,
-	_DICName[470:488]:                  DICTotalReactivePower,
-	strings.ToLower(_DICName[470:488]): DICTotalReactivePower,
-	_DICName[488:507]:                  DICPhaseAReactivePower,
-	strings.ToLower(_DICName[488:507]): DICPhaseAReactivePower,
-	_DICName[507:526]:                  DICPhaseBReactivePower,
-	strings.ToLower(_DICName[507:526]): DICPhaseBReactivePower,
-	_DICName[526:545]:                  DICPhaseCReactivePower,
-	strings.ToLower(_DICName[526:545]): DICPhaseCReactivePower,
-	_DICName[545:558]:                  DICReactivePower,
-	strings.ToLower(_DICName[545:558]): DICReactivePower,
-	_DICName[558:576]:                  DICTotalApparentPower,
-	strings.ToLower(_DICName[558:576]): DICTotalApparentPower,
-	_DICName[576:595]:                  DICPhaseAApparentPower,
-	strings.ToLower(_DICName[576:595]): DICPhaseAApparentPower,
-	_DICName[595:614]:                  DICPhaseBApparentPower,
-	strings.ToLower(_DICName[595:614]): DICPhaseBApparentPower,
-	_DICName[614:633]:                  DICPhaseCApparentPower,
-	strings.ToLower(_DICName[614:633]): DICPhaseCApparentPower,
-	_DICName[633:646]:                  DICApparentPower,
-	strings.ToLower(_DICName[633:646]): DICApparentPower,
-	_DICName[646:662]:                  DICTotalPowerFactor,
-	strings.ToLower(_DICName[646:662]): DICTotalPowerFactor,
-	_DICName[662:679]:                  DICPhaseAPowerFactor,
-	strings.ToLower(_DICName[662:679]): DICPhaseAPowerFactor,
-	_DICName[679:696]:                  DICPhaseBPowerFactor,
-	strings.ToLower(_DICName[679:696]): DICPhaseBPowerFactor,
-	_DICName[696:713]:                  DICPhaseCPowerFactor,
-	strings.ToLower(_DICName[696:713]): DICPhaseCPowerFactor,
-	_DICName[713:724]:                  DICPowerFactor,
-	strings.ToLower(_DICName[713:724]): DICPowerFactor,
-	_DICName[724:737]:                  DICABLineVoltage,
-	strings.ToLower(_DICName[724:737]): DICABLineVoltage,
-	_DICName[737:750]:                  DICBCLineVoltage,
-	strings.ToLower(_DICName[737:750]): DICBCLineVoltage,
-	_DICName[750:763]:                  DICCALineVoltage,
-	strings.ToLower(_DICName[750:763]): DICCALineVoltage,
-	_DICName[763:774]:                  DICLineVoltage,
-	strings.ToLower(_DICName[763:774]): DICLineVoltage,
-	_DICName[774:783]:                  DICFrequency,
-	strings.ToLower(_DICName[774:783]): DICFrequency,
-	_DICName[783:804]:                  DICTotalOverCurrentCount,
-	strings.ToLower(_DICName[783:804]): DICTotalOverCurrentCount,
-	_DICName[804:824]:                  DICTotalMeterResetCount,
-	strings.ToLower(_DICName[804:824]): DICTotalMeterResetCount,
-	_DICName[824:840]:                  DICMeterResetRecord,
-	strings.ToLower(_DICName[824:840]): DICMeterResetRecord,
-	_DICName[840:848]:                  DICDateTime,
-	strings.ToLower(_DICName[840:848]): DICDateTime,
-	_DICName[848:852]:                  DICTime,
-	strings.ToLower(_DICName[848:852]): DICTime,
-	_DICName[852:871]:                  DICAssetManagementCode,
-	strings.ToLower(_DICName[852:871]): DICAssetManagementCode,
-	_DICName[871:885]:                  DICActiveConstant,
-	strings.ToLower(_DICName[871:885]): DICActiveConstant,
-	_DICName[885:901]:                  DICReactiveConstant,
-	strings.ToLower(_DICName[885:901]): DICReactiveConstant,
+	_DICName[0:17]:                       DICTotalActiveEnergy,
+	strings.ToLower(_DICName[0:17]):      DICTotalActiveEnergy,
+	_DICName[17:42]:                      DICPositiveTotalActiveEnergy,
+	strings.ToLower(_DICName[17:42]):     DICPositiveTotalActiveEnergy,
+	_DICName[42:67]:                      DICPositiveActiveEnergyRate1,
+	strings.ToLower(_DICName[42:67]):     DICPositiveActiveEnergyRate1,
+	_DICName[67:92]:                      DICPositiveActiveEnergyRate2,
+	strings.ToLower(_DICName[67:92]):     DICPositiveActiveEnergyRate2,
+	_DICName[92:117]:                     DICPositiveActiveEnergyRate3,
+	strings.ToLower(_DICName[92:117]):    DICPositiveActiveEnergyRate3,
+	_DICName[117:142]:                    DICPositiveActiveEnergyRate4,
+	strings.ToLower(_DICName[117:142]):   DICPositiveActiveEnergyRate4,
+	_DICName[142:162]:                    DICPositiveActiveEnergy,
+	strings.ToLower(_DICName[142:162]):   DICPositiveActiveEnergy,
+	_DICName[162:187]:                    DICNegativeTotalActiveEnergy,
+	strings.ToLower(_DICName[162:187]):   DICNegativeTotalActiveEnergy,
+	_DICName[187:212]:                    DICNegativeActiveEnergyRate1,
+	strings.ToLower(_DICName[187:212]):   DICNegativeActiveEnergyRate1,
+	_DICName[212:237]:                    DICNegativeActiveEnergyRate2,
+	strings.ToLower(_DICName[212:237]):   DICNegativeActiveEnergyRate2,
+	_DICName[237:262]:                    DICNegativeActiveEnergyRate3,
+	strings.ToLower(_DICName[237:262]):   DICNegativeActiveEnergyRate3,
+	_DICName[262:287]:                    DICNegativeActiveEnergyRate4,
+	strings.ToLower(_DICName[262:287]):   DICNegativeActiveEnergyRate4,
+	_DICName[287:307]:                    DICNegativeActiveEnergy,
+	strings.ToLower(_DICName[287:307]):   DICNegativeActiveEnergy,
+	_DICName[307:327]:                    DICTotalReactiveEnergy1,
+	strings.ToLower(_DICName[307:327]):   DICTotalReactiveEnergy1,
+	_DICName[327:347]:                    DICReactiveEnergy1Rate1,
+	strings.ToLower(_DICName[327:347]):   DICReactiveEnergy1Rate1,
+	_DICName[347:367]:                    DICReactiveEnergy1Rate2,
+	strings.ToLower(_DICName[347:367]):   DICReactiveEnergy1Rate2,
+	_DICName[367:387]:                    DICReactiveEnergy1Rate3,
+	strings.ToLower(_DICName[367:387]):   DICReactiveEnergy1Rate3,
+	_DICName[387:407]:                    DICReactiveEnergy1Rate4,
+	strings.ToLower(_DICName[387:407]):   DICReactiveEnergy1Rate4,
+	_DICName[407:422]:                    DICReactiveEnergy1,
+	strings.ToLower(_DICName[407:422]):   DICReactiveEnergy1,
+	_DICName[422:442]:                    DICTotalReactiveEnergy2,
+	strings.ToLower(_DICName[422:442]):   DICTotalReactiveEnergy2,
+	_DICName[442:462]:                    DICReactiveEnergy2Rate1,
+	strings.ToLower(_DICName[442:462]):   DICReactiveEnergy2Rate1,
+	_DICName[462:482]:                    DICReactiveEnergy2Rate2,
+	strings.ToLower(_DICName[462:482]):   DICReactiveEnergy2Rate2,
+	_DICName[482:502]:                    DICReactiveEnergy2Rate3,
+	strings.ToLower(_DICName[482:502]):   DICReactiveEnergy2Rate3,
+	_DICName[502:522]:                    DICReactiveEnergy2Rate4,
+	strings.ToLower(_DICName[502:522]):   DICReactiveEnergy2Rate4,
+	_DICName[522:537]:                    DICReactiveEnergy2,
+	strings.ToLower(_DICName[522:537]):   DICReactiveEnergy2,
+	_DICName[537:564]:                    DICFirstQuadrantReactiveEnergy,
+	strings.ToLower(_DICName[537:564]):   DICFirstQuadrantReactiveEnergy,
+	_DICName[564:592]:                    DICSecondQuadrantReactiveEnergy,
+	strings.ToLower(_DICName[564:592]):   DICSecondQuadrantReactiveEnergy,
+	_DICName[592:619]:                    DICThirdQuadrantReactiveEnergy,
+	strings.ToLower(_DICName[592:619]):   DICThirdQuadrantReactiveEnergy,
+	_DICName[619:647]:                    DICFourthQuadrantReactiveEnergy,
+	strings.ToLower(_DICName[619:647]):   DICFourthQuadrantReactiveEnergy,
+	_DICName[647:674]:                    DICPositiveTotalApparentEnergy,
+	strings.ToLower(_DICName[647:674]):   DICPositiveTotalApparentEnergy,
+	_DICName[674:701]:                    DICNegativeTotalApparentEnergy,
+	strings.ToLower(_DICName[674:701]):   DICNegativeTotalApparentEnergy,
+	_DICName[701:730]:                    DICAssociatedTotalElectricEnergy,
+	strings.ToLower(_DICName[701:730]):   DICAssociatedTotalElectricEnergy,
+	_DICName[730:745]:                    DICRemainingEnergy,
+	strings.ToLower(_DICName[730:745]):   DICRemainingEnergy,
+	_DICName[745:760]:                    DICOverdraftEnergy,
+	strings.ToLower(_DICName[745:760]):   DICOverdraftEnergy,
+	_DICName[760:775]:                    DICRemainingAmount,
+	strings.ToLower(_DICName[760:775]):   DICRemainingAmount,
+	_DICName[775:790]:                    DICOverdraftAmount,
+	strings.ToLower(_DICName[775:790]):   DICOverdraftAmount,
+	_DICName[790:813]:                    DICPositiveActiveMaxDemand,
+	strings.ToLower(_DICName[790:813]):   DICPositiveActiveMaxDemand,
+	_DICName[813:841]:                    DICPositiveActiveMaxDemandRate1,
+	strings.ToLower(_DICName[813:841]):   DICPositiveActiveMaxDemandRate1,
+	_DICName[841:869]:                    DICPositiveActiveMaxDemandRate2,
+	strings.ToLower(_DICName[841:869]):   DICPositiveActiveMaxDemandRate2,
+	_DICName[869:897]:                    DICPositiveActiveMaxDemandRate3,
+	strings.ToLower(_DICName[869:897]):   DICPositiveActiveMaxDemandRate3,
+	_DICName[897:925]:                    DICPositiveActiveMaxDemandRate4,
+	strings.ToLower(_DICName[897:925]):   DICPositiveActiveMaxDemandRate4,
+	_DICName[925:953]:                    DICPositiveActiveMaxDemandBlock,
+	strings.ToLower(_DICName[925:953]):   DICPositiveActiveMaxDemandBlock,
+	_DICName[953:976]:                    DICNegativeActiveMaxDemand,
+	strings.ToLower(_DICName[953:976]):   DICNegativeActiveMaxDemand,
+	_DICName[976:994]:                    DICReactiveMaxDemand1,
+	strings.ToLower(_DICName[976:994]):   DICReactiveMaxDemand1,
+	_DICName[994:1012]:                   DICReactiveMaxDemand2,
+	strings.ToLower(_DICName[994:1012]):  DICReactiveMaxDemand2,
+	_DICName[1012:1025]:                  DICPhaseAVoltage,
+	strings.ToLower(_DICName[1012:1025]): DICPhaseAVoltage,
+	_DICName[1025:1038]:                  DICPhaseBVoltage,
+	strings.ToLower(_DICName[1025:1038]): DICPhaseBVoltage,
+	_DICName[1038:1051]:                  DICPhaseCVoltage,
+	strings.ToLower(_DICName[1038:1051]): DICPhaseCVoltage,
+	_DICName[1051:1058]:                  DICVoltage,
+	strings.ToLower(_DICName[1051:1058]): DICVoltage,
+	_DICName[1058:1071]:                  DICPhaseACurrent,
+	strings.ToLower(_DICName[1058:1071]): DICPhaseACurrent,
+	_DICName[1071:1084]:                  DICPhaseBCurrent,
+	strings.ToLower(_DICName[1071:1084]): DICPhaseBCurrent,
+	_DICName[1084:1097]:                  DICPhaseCCurrent,
+	strings.ToLower(_DICName[1084:1097]): DICPhaseCCurrent,
+	_DICName[1097:1104]:                  DICCurrent,
+	strings.ToLower(_DICName[1097:1104]): DICCurrent,
+	_DICName[1104:1120]:                  DICTotalActivePower,
+	strings.ToLower(_DICName[1104:1120]): DICTotalActivePower,
+	_DICName[1120:1137]:                  DICPhaseAActivePower,
+	strings.ToLower(_DICName[1120:1137]): DICPhaseAActivePower,
+	_DICName[1137:1154]:                  DICPhaseBActivePower,
+	strings.ToLower(_DICName[1137:1154]): DICPhaseBActivePower,
+	_DICName[1154:1171]:                  DICPhaseCActivePower,
+	strings.ToLower(_DICName[1154:1171]): DICPhaseCActivePower,
+	_DICName[1171:1182]:                  DICActivePower,
+	strings.ToLower(_DICName[1171:1182]): DICActivePower,
+	_DICName[1182:1200]:                  DICTotalReactivePower,
+	strings.ToLower(_DICName[1182:1200]): DICTotalReactivePower,
+	_DICName[1200:1219]:                  DICPhaseAReactivePower,
+	strings.ToLower(_DICName[1200:1219]): DICPhaseAReactivePower,
+	_DICName[1219:1238]:                  DICPhaseBReactivePower,
+	strings.ToLower(_DICName[1219:1238]): DICPhaseBReactivePower,
+	_DICName[1238:1257]:                  DICPhaseCReactivePower,
+	strings.ToLower(_DICName[1238:1257]): DICPhaseCReactivePower,
+	_DICName[1257:1270]:                  DICReactivePower,
+	strings.ToLower(_DICName[1257:1270]): DICReactivePower,
+	_DICName[1270:1288]:                  DICTotalApparentPower,
+	strings.ToLower(_DICName[1270:1288]): DICTotalApparentPower,
+	_DICName[1288:1307]:                  DICPhaseAApparentPower,
+	strings.ToLower(_DICName[1288:1307]): DICPhaseAApparentPower,
+	_DICName[1307:1326]:                  DICPhaseBApparentPower,
+	strings.ToLower(_DICName[1307:1326]): DICPhaseBApparentPower,
+	_DICName[1326:1345]:                  DICPhaseCApparentPower,
+	strings.ToLower(_DICName[1326:1345]): DICPhaseCApparentPower,
+	_DICName[1345:1358]:                  DICApparentPower,
+	strings.ToLower(_DICName[1345:1358]): DICApparentPower,
+	_DICName[1358:1374]:                  DICTotalPowerFactor,
+	strings.ToLower(_DICName[1358:1374]): DICTotalPowerFactor,
+	_DICName[1374:1391]:                  DICPhaseAPowerFactor,
+	strings.ToLower(_DICName[1374:1391]): DICPhaseAPowerFactor,
+	_DICName[1391:1408]:                  DICPhaseBPowerFactor,
+	strings.ToLower(_DICName[1391:1408]): DICPhaseBPowerFactor,
+	_DICName[1408:1425]:                  DICPhaseCPowerFactor,
+	strings.ToLower(_DICName[1408:1425]): DICPhaseCPowerFactor,
+	_DICName[1425:1436]:                  DICPowerFactor,
+	strings.ToLower(_DICName[1425:1436]): DICPowerFactor,
+	_DICName[1436:1447]:                  DICPhaseAAngle,
+	strings.ToLower(_DICName[1436:1447]): DICPhaseAAngle,
+	_DICName[1447:1458]:                  DICPhaseBAngle,
+	strings.ToLower(_DICName[1447:1458]): DICPhaseBAngle,
+	_DICName[1458:1469]:                  DICPhaseCAngle,
+	strings.ToLower(_DICName[1458:1469]): DICPhaseCAngle,
+	_DICName[1469:1479]:                  DICPhaseAngle,
+	strings.ToLower(_DICName[1469:1479]): DICPhaseAngle,
+	_DICName[1479:1495]:                  DICPhaseAVoltageTHD,
+	strings.ToLower(_DICName[1479:1495]): DICPhaseAVoltageTHD,
+	_DICName[1495:1517]:                  DICPhaseAVoltageHarmonic2,
+	strings.ToLower(_DICName[1495:1517]): DICPhaseAVoltageHarmonic2,
+	_DICName[1517:1539]:                  DICPhaseAVoltageHarmonic3,
+	strings.ToLower(_DICName[1517:1539]): DICPhaseAVoltageHarmonic3,
+	_DICName[1539:1561]:                  DICPhaseAVoltageHarmonic4,
+	strings.ToLower(_DICName[1539:1561]): DICPhaseAVoltageHarmonic4,
+	_DICName[1561:1583]:                  DICPhaseAVoltageHarmonic5,
+	strings.ToLower(_DICName[1561:1583]): DICPhaseAVoltageHarmonic5,
+	_DICName[1583:1605]:                  DICPhaseAVoltageHarmonic6,
+	strings.ToLower(_DICName[1583:1605]): DICPhaseAVoltageHarmonic6,
+	_DICName[1605:1627]:                  DICPhaseAVoltageHarmonic7,
+	strings.ToLower(_DICName[1605:1627]): DICPhaseAVoltageHarmonic7,
+	_DICName[1627:1649]:                  DICPhaseAVoltageHarmonic8,
+	strings.ToLower(_DICName[1627:1649]): DICPhaseAVoltageHarmonic8,
+	_DICName[1649:1671]:                  DICPhaseAVoltageHarmonic9,
+	strings.ToLower(_DICName[1649:1671]): DICPhaseAVoltageHarmonic9,
+	_DICName[1671:1694]:                  DICPhaseAVoltageHarmonic10,
+	strings.ToLower(_DICName[1671:1694]): DICPhaseAVoltageHarmonic10,
+	_DICName[1694:1717]:                  DICPhaseAVoltageHarmonic11,
+	strings.ToLower(_DICName[1694:1717]): DICPhaseAVoltageHarmonic11,
+	_DICName[1717:1740]:                  DICPhaseAVoltageHarmonic12,
+	strings.ToLower(_DICName[1717:1740]): DICPhaseAVoltageHarmonic12,
+	_DICName[1740:1763]:                  DICPhaseAVoltageHarmonic13,
+	strings.ToLower(_DICName[1740:1763]): DICPhaseAVoltageHarmonic13,
+	_DICName[1763:1786]:                  DICPhaseAVoltageHarmonic14,
+	strings.ToLower(_DICName[1763:1786]): DICPhaseAVoltageHarmonic14,
+	_DICName[1786:1809]:                  DICPhaseAVoltageHarmonic15,
+	strings.ToLower(_DICName[1786:1809]): DICPhaseAVoltageHarmonic15,
+	_DICName[1809:1832]:                  DICPhaseAVoltageHarmonic16,
+	strings.ToLower(_DICName[1809:1832]): DICPhaseAVoltageHarmonic16,
+	_DICName[1832:1855]:                  DICPhaseAVoltageHarmonic17,
+	strings.ToLower(_DICName[1832:1855]): DICPhaseAVoltageHarmonic17,
+	_DICName[1855:1878]:                  DICPhaseAVoltageHarmonic18,
+	strings.ToLower(_DICName[1855:1878]): DICPhaseAVoltageHarmonic18,
+	_DICName[1878:1901]:                  DICPhaseAVoltageHarmonic19,
+	strings.ToLower(_DICName[1878:1901]): DICPhaseAVoltageHarmonic19,
+	_DICName[1901:1924]:                  DICPhaseAVoltageHarmonic20,
+	strings.ToLower(_DICName[1901:1924]): DICPhaseAVoltageHarmonic20,
+	_DICName[1924:1947]:                  DICPhaseAVoltageHarmonic21,
+	strings.ToLower(_DICName[1924:1947]): DICPhaseAVoltageHarmonic21,
+	_DICName[1947:1968]:                  DICPhaseAVoltageHarmonic,
+	strings.ToLower(_DICName[1947:1968]): DICPhaseAVoltageHarmonic,
+	_DICName[1968:1984]:                  DICPhaseBVoltageTHD,
+	strings.ToLower(_DICName[1968:1984]): DICPhaseBVoltageTHD,
+	_DICName[1984:2006]:                  DICPhaseBVoltageHarmonic2,
+	strings.ToLower(_DICName[1984:2006]): DICPhaseBVoltageHarmonic2,
+	_DICName[2006:2028]:                  DICPhaseBVoltageHarmonic3,
+	strings.ToLower(_DICName[2006:2028]): DICPhaseBVoltageHarmonic3,
+	_DICName[2028:2050]:                  DICPhaseBVoltageHarmonic4,
+	strings.ToLower(_DICName[2028:2050]): DICPhaseBVoltageHarmonic4,
+	_DICName[2050:2072]:                  DICPhaseBVoltageHarmonic5,
+	strings.ToLower(_DICName[2050:2072]): DICPhaseBVoltageHarmonic5,
+	_DICName[2072:2094]:                  DICPhaseBVoltageHarmonic6,
+	strings.ToLower(_DICName[2072:2094]): DICPhaseBVoltageHarmonic6,
+	_DICName[2094:2116]:                  DICPhaseBVoltageHarmonic7,
+	strings.ToLower(_DICName[2094:2116]): DICPhaseBVoltageHarmonic7,
+	_DICName[2116:2138]:                  DICPhaseBVoltageHarmonic8,
+	strings.ToLower(_DICName[2116:2138]): DICPhaseBVoltageHarmonic8,
+	_DICName[2138:2160]:                  DICPhaseBVoltageHarmonic9,
+	strings.ToLower(_DICName[2138:2160]): DICPhaseBVoltageHarmonic9,
+	_DICName[2160:2183]:                  DICPhaseBVoltageHarmonic10,
+	strings.ToLower(_DICName[2160:2183]): DICPhaseBVoltageHarmonic10,
+	_DICName[2183:2206]:                  DICPhaseBVoltageHarmonic11,
+	strings.ToLower(_DICName[2183:2206]): DICPhaseBVoltageHarmonic11,
+	_DICName[2206:2229]:                  DICPhaseBVoltageHarmonic12,
+	strings.ToLower(_DICName[2206:2229]): DICPhaseBVoltageHarmonic12,
+	_DICName[2229:2252]:                  DICPhaseBVoltageHarmonic13,
+	strings.ToLower(_DICName[2229:2252]): DICPhaseBVoltageHarmonic13,
+	_DICName[2252:2275]:                  DICPhaseBVoltageHarmonic14,
+	strings.ToLower(_DICName[2252:2275]): DICPhaseBVoltageHarmonic14,
+	_DICName[2275:2298]:                  DICPhaseBVoltageHarmonic15,
+	strings.ToLower(_DICName[2275:2298]): DICPhaseBVoltageHarmonic15,
+	_DICName[2298:2321]:                  DICPhaseBVoltageHarmonic16,
+	strings.ToLower(_DICName[2298:2321]): DICPhaseBVoltageHarmonic16,
+	_DICName[2321:2344]:                  DICPhaseBVoltageHarmonic17,
+	strings.ToLower(_DICName[2321:2344]): DICPhaseBVoltageHarmonic17,
+	_DICName[2344:2367]:                  DICPhaseBVoltageHarmonic18,
+	strings.ToLower(_DICName[2344:2367]): DICPhaseBVoltageHarmonic18,
+	_DICName[2367:2390]:                  DICPhaseBVoltageHarmonic19,
+	strings.ToLower(_DICName[2367:2390]): DICPhaseBVoltageHarmonic19,
+	_DICName[2390:2413]:                  DICPhaseBVoltageHarmonic20,
+	strings.ToLower(_DICName[2390:2413]): DICPhaseBVoltageHarmonic20,
+	_DICName[2413:2436]:                  DICPhaseBVoltageHarmonic21,
+	strings.ToLower(_DICName[2413:2436]): DICPhaseBVoltageHarmonic21,
+	_DICName[2436:2457]:                  DICPhaseBVoltageHarmonic,
+	strings.ToLower(_DICName[2436:2457]): DICPhaseBVoltageHarmonic,
+	_DICName[2457:2473]:                  DICPhaseCVoltageTHD,
+	strings.ToLower(_DICName[2457:2473]): DICPhaseCVoltageTHD,
+	_DICName[2473:2495]:                  DICPhaseCVoltageHarmonic2,
+	strings.ToLower(_DICName[2473:2495]): DICPhaseCVoltageHarmonic2,
+	_DICName[2495:2517]:                  DICPhaseCVoltageHarmonic3,
+	strings.ToLower(_DICName[2495:2517]): DICPhaseCVoltageHarmonic3,
+	_DICName[2517:2539]:                  DICPhaseCVoltageHarmonic4,
+	strings.ToLower(_DICName[2517:2539]): DICPhaseCVoltageHarmonic4,
+	_DICName[2539:2561]:                  DICPhaseCVoltageHarmonic5,
+	strings.ToLower(_DICName[2539:2561]): DICPhaseCVoltageHarmonic5,
+	_DICName[2561:2583]:                  DICPhaseCVoltageHarmonic6,
+	strings.ToLower(_DICName[2561:2583]): DICPhaseCVoltageHarmonic6,
+	_DICName[2583:2605]:                  DICPhaseCVoltageHarmonic7,
+	strings.ToLower(_DICName[2583:2605]): DICPhaseCVoltageHarmonic7,
+	_DICName[2605:2627]:                  DICPhaseCVoltageHarmonic8,
+	strings.ToLower(_DICName[2605:2627]): DICPhaseCVoltageHarmonic8,
+	_DICName[2627:2649]:                  DICPhaseCVoltageHarmonic9,
+	strings.ToLower(_DICName[2627:2649]): DICPhaseCVoltageHarmonic9,
+	_DICName[2649:2672]:                  DICPhaseCVoltageHarmonic10,
+	strings.ToLower(_DICName[2649:2672]): DICPhaseCVoltageHarmonic10,
+	_DICName[2672:2695]:                  DICPhaseCVoltageHarmonic11,
+	strings.ToLower(_DICName[2672:2695]): DICPhaseCVoltageHarmonic11,
+	_DICName[2695:2718]:                  DICPhaseCVoltageHarmonic12,
+	strings.ToLower(_DICName[2695:2718]): DICPhaseCVoltageHarmonic12,
+	_DICName[2718:2741]:                  DICPhaseCVoltageHarmonic13,
+	strings.ToLower(_DICName[2718:2741]): DICPhaseCVoltageHarmonic13,
+	_DICName[2741:2764]:                  DICPhaseCVoltageHarmonic14,
+	strings.ToLower(_DICName[2741:2764]): DICPhaseCVoltageHarmonic14,
+	_DICName[2764:2787]:                  DICPhaseCVoltageHarmonic15,
+	strings.ToLower(_DICName[2764:2787]): DICPhaseCVoltageHarmonic15,
+	_DICName[2787:2810]:                  DICPhaseCVoltageHarmonic16,
+	strings.ToLower(_DICName[2787:2810]): DICPhaseCVoltageHarmonic16,
+	_DICName[2810:2833]:                  DICPhaseCVoltageHarmonic17,
+	strings.ToLower(_DICName[2810:2833]): DICPhaseCVoltageHarmonic17,
+	_DICName[2833:2856]:                  DICPhaseCVoltageHarmonic18,
+	strings.ToLower(_DICName[2833:2856]): DICPhaseCVoltageHarmonic18,
+	_DICName[2856:2879]:                  DICPhaseCVoltageHarmonic19,
+	strings.ToLower(_DICName[2856:2879]): DICPhaseCVoltageHarmonic19,
+	_DICName[2879:2902]:                  DICPhaseCVoltageHarmonic20,
+	strings.ToLower(_DICName[2879:2902]): DICPhaseCVoltageHarmonic20,
+	_DICName[2902:2925]:                  DICPhaseCVoltageHarmonic21,
+	strings.ToLower(_DICName[2902:2925]): DICPhaseCVoltageHarmonic21,
+	_DICName[2925:2946]:                  DICPhaseCVoltageHarmonic,
+	strings.ToLower(_DICName[2925:2946]): DICPhaseCVoltageHarmonic,
+	_DICName[2946:2962]:                  DICPhaseACurrentTHD,
+	strings.ToLower(_DICName[2946:2962]): DICPhaseACurrentTHD,
+	_DICName[2962:2984]:                  DICPhaseACurrentHarmonic2,
+	strings.ToLower(_DICName[2962:2984]): DICPhaseACurrentHarmonic2,
+	_DICName[2984:3006]:                  DICPhaseACurrentHarmonic3,
+	strings.ToLower(_DICName[2984:3006]): DICPhaseACurrentHarmonic3,
+	_DICName[3006:3028]:                  DICPhaseACurrentHarmonic4,
+	strings.ToLower(_DICName[3006:3028]): DICPhaseACurrentHarmonic4,
+	_DICName[3028:3050]:                  DICPhaseACurrentHarmonic5,
+	strings.ToLower(_DICName[3028:3050]): DICPhaseACurrentHarmonic5,
+	_DICName[3050:3072]:                  DICPhaseACurrentHarmonic6,
+	strings.ToLower(_DICName[3050:3072]): DICPhaseACurrentHarmonic6,
+	_DICName[3072:3094]:                  DICPhaseACurrentHarmonic7,
+	strings.ToLower(_DICName[3072:3094]): DICPhaseACurrentHarmonic7,
+	_DICName[3094:3116]:                  DICPhaseACurrentHarmonic8,
+	strings.ToLower(_DICName[3094:3116]): DICPhaseACurrentHarmonic8,
+	_DICName[3116:3138]:                  DICPhaseACurrentHarmonic9,
+	strings.ToLower(_DICName[3116:3138]): DICPhaseACurrentHarmonic9,
+	_DICName[3138:3161]:                  DICPhaseACurrentHarmonic10,
+	strings.ToLower(_DICName[3138:3161]): DICPhaseACurrentHarmonic10,
+	_DICName[3161:3184]:                  DICPhaseACurrentHarmonic11,
+	strings.ToLower(_DICName[3161:3184]): DICPhaseACurrentHarmonic11,
+	_DICName[3184:3207]:                  DICPhaseACurrentHarmonic12,
+	strings.ToLower(_DICName[3184:3207]): DICPhaseACurrentHarmonic12,
+	_DICName[3207:3230]:                  DICPhaseACurrentHarmonic13,
+	strings.ToLower(_DICName[3207:3230]): DICPhaseACurrentHarmonic13,
+	_DICName[3230:3253]:                  DICPhaseACurrentHarmonic14,
+	strings.ToLower(_DICName[3230:3253]): DICPhaseACurrentHarmonic14,
+	_DICName[3253:3276]:                  DICPhaseACurrentHarmonic15,
+	strings.ToLower(_DICName[3253:3276]): DICPhaseACurrentHarmonic15,
+	_DICName[3276:3299]:                  DICPhaseACurrentHarmonic16,
+	strings.ToLower(_DICName[3276:3299]): DICPhaseACurrentHarmonic16,
+	_DICName[3299:3322]:                  DICPhaseACurrentHarmonic17,
+	strings.ToLower(_DICName[3299:3322]): DICPhaseACurrentHarmonic17,
+	_DICName[3322:3345]:                  DICPhaseACurrentHarmonic18,
+	strings.ToLower(_DICName[3322:3345]): DICPhaseACurrentHarmonic18,
+	_DICName[3345:3368]:                  DICPhaseACurrentHarmonic19,
+	strings.ToLower(_DICName[3345:3368]): DICPhaseACurrentHarmonic19,
+	_DICName[3368:3391]:                  DICPhaseACurrentHarmonic20,
+	strings.ToLower(_DICName[3368:3391]): DICPhaseACurrentHarmonic20,
+	_DICName[3391:3414]:                  DICPhaseACurrentHarmonic21,
+	strings.ToLower(_DICName[3391:3414]): DICPhaseACurrentHarmonic21,
+	_DICName[3414:3435]:                  DICPhaseACurrentHarmonic,
+	strings.ToLower(_DICName[3414:3435]): DICPhaseACurrentHarmonic,
+	_DICName[3435:3451]:                  DICPhaseBCurrentTHD,
+	strings.ToLower(_DICName[3435:3451]): DICPhaseBCurrentTHD,
+	_DICName[3451:3473]:                  DICPhaseBCurrentHarmonic2,
+	strings.ToLower(_DICName[3451:3473]): DICPhaseBCurrentHarmonic2,
+	_DICName[3473:3495]:                  DICPhaseBCurrentHarmonic3,
+	strings.ToLower(_DICName[3473:3495]): DICPhaseBCurrentHarmonic3,
+	_DICName[3495:3517]:                  DICPhaseBCurrentHarmonic4,
+	strings.ToLower(_DICName[3495:3517]): DICPhaseBCurrentHarmonic4,
+	_DICName[3517:3539]:                  DICPhaseBCurrentHarmonic5,
+	strings.ToLower(_DICName[3517:3539]): DICPhaseBCurrentHarmonic5,
+	_DICName[3539:3561]:                  DICPhaseBCurrentHarmonic6,
+	strings.ToLower(_DICName[3539:3561]): DICPhaseBCurrentHarmonic6,
+	_DICName[3561:3583]:                  DICPhaseBCurrentHarmonic7,
+	strings.ToLower(_DICName[3561:3583]): DICPhaseBCurrentHarmonic7,
+	_DICName[3583:3605]:                  DICPhaseBCurrentHarmonic8,
+	strings.ToLower(_DICName[3583:3605]): DICPhaseBCurrentHarmonic8,
+	_DICName[3605:3627]:                  DICPhaseBCurrentHarmonic9,
+	strings.ToLower(_DICName[3605:3627]): DICPhaseBCurrentHarmonic9,
+	_DICName[3627:3650]:                  DICPhaseBCurrentHarmonic10,
+	strings.ToLower(_DICName[3627:3650]): DICPhaseBCurrentHarmonic10,
+	_DICName[3650:3673]:                  DICPhaseBCurrentHarmonic11,
+	strings.ToLower(_DICName[3650:3673]): DICPhaseBCurrentHarmonic11,
+	_DICName[3673:3696]:                  DICPhaseBCurrentHarmonic12,
+	strings.ToLower(_DICName[3673:3696]): DICPhaseBCurrentHarmonic12,
+	_DICName[3696:3719]:                  DICPhaseBCurrentHarmonic13,
+	strings.ToLower(_DICName[3696:3719]): DICPhaseBCurrentHarmonic13,
+	_DICName[3719:3742]:                  DICPhaseBCurrentHarmonic14,
+	strings.ToLower(_DICName[3719:3742]): DICPhaseBCurrentHarmonic14,
+	_DICName[3742:3765]:                  DICPhaseBCurrentHarmonic15,
+	strings.ToLower(_DICName[3742:3765]): DICPhaseBCurrentHarmonic15,
+	_DICName[3765:3788]:                  DICPhaseBCurrentHarmonic16,
+	strings.ToLower(_DICName[3765:3788]): DICPhaseBCurrentHarmonic16,
+	_DICName[3788:3811]:                  DICPhaseBCurrentHarmonic17,
+	strings.ToLower(_DICName[3788:3811]): DICPhaseBCurrentHarmonic17,
+	_DICName[3811:3834]:                  DICPhaseBCurrentHarmonic18,
+	strings.ToLower(_DICName[3811:3834]): DICPhaseBCurrentHarmonic18,
+	_DICName[3834:3857]:                  DICPhaseBCurrentHarmonic19,
+	strings.ToLower(_DICName[3834:3857]): DICPhaseBCurrentHarmonic19,
+	_DICName[3857:3880]:                  DICPhaseBCurrentHarmonic20,
+	strings.ToLower(_DICName[3857:3880]): DICPhaseBCurrentHarmonic20,
+	_DICName[3880:3903]:                  DICPhaseBCurrentHarmonic21,
+	strings.ToLower(_DICName[3880:3903]): DICPhaseBCurrentHarmonic21,
+	_DICName[3903:3924]:                  DICPhaseBCurrentHarmonic,
+	strings.ToLower(_DICName[3903:3924]): DICPhaseBCurrentHarmonic,
+	_DICName[3924:3940]:                  DICPhaseCCurrentTHD,
+	strings.ToLower(_DICName[3924:3940]): DICPhaseCCurrentTHD,
+	_DICName[3940:3962]:                  DICPhaseCCurrentHarmonic2,
+	strings.ToLower(_DICName[3940:3962]): DICPhaseCCurrentHarmonic2,
+	_DICName[3962:3984]:                  DICPhaseCCurrentHarmonic3,
+	strings.ToLower(_DICName[3962:3984]): DICPhaseCCurrentHarmonic3,
+	_DICName[3984:4006]:                  DICPhaseCCurrentHarmonic4,
+	strings.ToLower(_DICName[3984:4006]): DICPhaseCCurrentHarmonic4,
+	_DICName[4006:4028]:                  DICPhaseCCurrentHarmonic5,
+	strings.ToLower(_DICName[4006:4028]): DICPhaseCCurrentHarmonic5,
+	_DICName[4028:4050]:                  DICPhaseCCurrentHarmonic6,
+	strings.ToLower(_DICName[4028:4050]): DICPhaseCCurrentHarmonic6,
+	_DICName[4050:4072]:                  DICPhaseCCurrentHarmonic7,
+	strings.ToLower(_DICName[4050:4072]): DICPhaseCCurrentHarmonic7,
+	_DICName[4072:4094]:                  DICPhaseCCurrentHarmonic8,
+	strings.ToLower(_DICName[4072:4094]): DICPhaseCCurrentHarmonic8,
+	_DICName[4094:4116]:                  DICPhaseCCurrentHarmonic9,
+	strings.ToLower(_DICName[4094:4116]): DICPhaseCCurrentHarmonic9,
+	_DICName[4116:4139]:                  DICPhaseCCurrentHarmonic10,
+	strings.ToLower(_DICName[4116:4139]): DICPhaseCCurrentHarmonic10,
+	_DICName[4139:4162]:                  DICPhaseCCurrentHarmonic11,
+	strings.ToLower(_DICName[4139:4162]): DICPhaseCCurrentHarmonic11,
+	_DICName[4162:4185]:                  DICPhaseCCurrentHarmonic12,
+	strings.ToLower(_DICName[4162:4185]): DICPhaseCCurrentHarmonic12,
+	_DICName[4185:4208]:                  DICPhaseCCurrentHarmonic13,
+	strings.ToLower(_DICName[4185:4208]): DICPhaseCCurrentHarmonic13,
+	_DICName[4208:4231]:                  DICPhaseCCurrentHarmonic14,
+	strings.ToLower(_DICName[4208:4231]): DICPhaseCCurrentHarmonic14,
+	_DICName[4231:4254]:                  DICPhaseCCurrentHarmonic15,
+	strings.ToLower(_DICName[4231:4254]): DICPhaseCCurrentHarmonic15,
+	_DICName[4254:4277]:                  DICPhaseCCurrentHarmonic16,
+	strings.ToLower(_DICName[4254:4277]): DICPhaseCCurrentHarmonic16,
+	_DICName[4277:4300]:                  DICPhaseCCurrentHarmonic17,
+	strings.ToLower(_DICName[4277:4300]): DICPhaseCCurrentHarmonic17,
+	_DICName[4300:4323]:                  DICPhaseCCurrentHarmonic18,
+	strings.ToLower(_DICName[4300:4323]): DICPhaseCCurrentHarmonic18,
+	_DICName[4323:4346]:                  DICPhaseCCurrentHarmonic19,
+	strings.ToLower(_DICName[4323:4346]): DICPhaseCCurrentHarmonic19,
+	_DICName[4346:4369]:                  DICPhaseCCurrentHarmonic20,
+	strings.ToLower(_DICName[4346:4369]): DICPhaseCCurrentHarmonic20,
+	_DICName[4369:4392]:                  DICPhaseCCurrentHarmonic21,
+	strings.ToLower(_DICName[4369:4392]): DICPhaseCCurrentHarmonic21,
+	_DICName[4392:4413]:                  DICPhaseCCurrentHarmonic,
+	strings.ToLower(_DICName[4392:4413]): DICPhaseCCurrentHarmonic,
+	_DICName[4413:4426]:                  DICABLineVoltage,
+	strings.ToLower(_DICName[4413:4426]): DICABLineVoltage,
+	_DICName[4426:4439]:                  DICBCLineVoltage,
+	strings.ToLower(_DICName[4426:4439]): DICBCLineVoltage,
+	_DICName[4439:4452]:                  DICCALineVoltage,
+	strings.ToLower(_DICName[4439:4452]): DICCALineVoltage,
+	_DICName[4452:4463]:                  DICLineVoltage,
+	strings.ToLower(_DICName[4452:4463]): DICLineVoltage,
+	_DICName[4463:4477]:                  DICNeutralCurrent,
+	strings.ToLower(_DICName[4463:4477]): DICNeutralCurrent,
+	_DICName[4477:4486]:                  DICFrequency,
+	strings.ToLower(_DICName[4477:4486]): DICFrequency,
+	_DICName[4486:4504]:                  DICAverageActivePower,
+	strings.ToLower(_DICName[4486:4504]): DICAverageActivePower,
+	_DICName[4504:4516]:                  DICActiveDemand,
+	strings.ToLower(_DICName[4504:4516]): DICActiveDemand,
+	_DICName[4516:4530]:                  DICReactiveDemand,
+	strings.ToLower(_DICName[4516:4530]): DICReactiveDemand,
+	_DICName[4530:4544]:                  DICApparentDemand,
+	strings.ToLower(_DICName[4530:4544]): DICApparentDemand,
+	_DICName[4544:4555]:                  DICTemperature,
+	strings.ToLower(_DICName[4544:4555]): DICTemperature,
+	_DICName[4555:4574]:                  DICClockBatteryVoltage,
+	strings.ToLower(_DICName[4555:4574]): DICClockBatteryVoltage,
+	_DICName[4574:4595]:                  DICReadingBatteryVoltage,
+	strings.ToLower(_DICName[4574:4595]): DICReadingBatteryVoltage,
+	_DICName[4595:4609]:                  DICBatteryRunTime,
+	strings.ToLower(_DICName[4595:4609]): DICBatteryRunTime,
+	_DICName[4609:4627]:                  DICCurrentTariffPrice,
+	strings.ToLower(_DICName[4609:4627]): DICCurrentTariffPrice,
+	_DICName[4627:4648]:                  DICTotalOverCurrentCount,
+	strings.ToLower(_DICName[4627:4648]): DICTotalOverCurrentCount,
+	_DICName[4648:4667]:                  DICTotalPowerDownCount,
+	strings.ToLower(_DICName[4648:4667]): DICTotalPowerDownCount,
+	_DICName[4667:4682]:                  DICPowerDownRecord,
+	strings.ToLower(_DICName[4667:4682]): DICPowerDownRecord,
+	_DICName[4682:4699]:                  DICTotalProgramCount,
+	strings.ToLower(_DICName[4682:4699]): DICTotalProgramCount,
+	_DICName[4699:4712]:                  DICProgramRecord,
+	strings.ToLower(_DICName[4699:4712]): DICProgramRecord,
+	_DICName[4712:4732]:                  DICTotalMeterResetCount,
+	strings.ToLower(_DICName[4712:4732]): DICTotalMeterResetCount,
+	_DICName[4732:4748]:                  DICMeterResetRecord,
+	strings.ToLower(_DICName[4732:4748]): DICMeterResetRecord,
+	_DICName[4748:4769]:                  DICTotalDemandResetCount,
+	strings.ToLower(_DICName[4748:4769]): DICTotalDemandResetCount,
+	_DICName[4769:4786]:                  DICDemandResetRecord,
+	strings.ToLower(_DICName[4769:4786]): DICDemandResetRecord,
+	_DICName[4786:4806]:                  DICTotalEventResetCount,
+	strings.ToLower(_DICName[4786:4806]): DICTotalEventResetCount,
+	_DICName[4806:4822]:                  DICEventResetRecord,
+	strings.ToLower(_DICName[4806:4822]): DICEventResetRecord,
+	_DICName[4822:4843]:                  DICTotalClockAdjustCount,
+	strings.ToLower(_DICName[4822:4843]): DICTotalClockAdjustCount,
+	_DICName[4843:4860]:                  DICClockAdjustRecord,
+	strings.ToLower(_DICName[4843:4860]): DICClockAdjustRecord,
+	_DICName[4860:4884]:                  DICTotalMeterCoverOpenCount,
+	strings.ToLower(_DICName[4860:4884]): DICTotalMeterCoverOpenCount,
+	_DICName[4884:4904]:                  DICMeterCoverOpenRecord,
+	strings.ToLower(_DICName[4884:4904]): DICMeterCoverOpenRecord,
+	_DICName[4904:4931]:                  DICTotalTerminalCoverOpenCount,
+	strings.ToLower(_DICName[4904:4931]): DICTotalTerminalCoverOpenCount,
+	_DICName[4931:4954]:                  DICTerminalCoverOpenRecord,
+	strings.ToLower(_DICName[4931:4954]): DICTerminalCoverOpenRecord,
+	_DICName[4954:4970]:                  DICLastPurchaseTime,
+	strings.ToLower(_DICName[4954:4970]): DICLastPurchaseTime,
+	_DICName[4970:4987]:                  DICLastPurchaseCount,
+	strings.ToLower(_DICName[4970:4987]): DICLastPurchaseCount,
+	_DICName[4987:5005]:                  DICLastPurchaseAmount,
+	strings.ToLower(_DICName[4987:5005]): DICLastPurchaseAmount,
+	_DICName[5005:5030]:                  DICLastPurchaseBalanceBefore,
+	strings.ToLower(_DICName[5005:5030]): DICLastPurchaseBalanceBefore,
+	_DICName[5030:5054]:                  DICLastPurchaseBalanceAfter,
+	strings.ToLower(_DICName[5030:5054]): DICLastPurchaseBalanceAfter,
+	_DICName[5054:5077]:                  DICLastTotalPurchaseAmount,
+	strings.ToLower(_DICName[5054:5077]): DICLastTotalPurchaseAmount,
+	_DICName[5077:5085]:                  DICDateTime,
+	strings.ToLower(_DICName[5077:5085]): DICDateTime,
+	_DICName[5085:5089]:                  DICTime,
+	strings.ToLower(_DICName[5085:5089]): DICTime,
+	_DICName[5089:5101]:                  DICDemandPeriod,
+	strings.ToLower(_DICName[5089:5101]): DICDemandPeriod,
+	_DICName[5101:5112]:                  DICSlidingTime,
+	strings.ToLower(_DICName[5101:5112]): DICSlidingTime,
+	_DICName[5112:5130]:                  DICTimeZoneSwitchTime,
+	strings.ToLower(_DICName[5112:5130]): DICTimeZoneSwitchTime,
+	_DICName[5130:5150]:                  DICDailyTableSwitchTime,
+	strings.ToLower(_DICName[5130:5150]): DICDailyTableSwitchTime,
+	_DICName[5150:5166]:                  DICTariffSwitchTime,
+	strings.ToLower(_DICName[5150:5166]): DICTariffSwitchTime,
+	_DICName[5166:5180]:                  DICStepSwitchTime,
+	strings.ToLower(_DICName[5166:5180]): DICStepSwitchTime,
+	_DICName[5180:5193]:                  DICTimeZoneCount,
+	strings.ToLower(_DICName[5180:5193]): DICTimeZoneCount,
+	_DICName[5193:5208]:                  DICDailyTableCount,
+	strings.ToLower(_DICName[5193:5208]): DICDailyTableCount,
+	_DICName[5208:5219]:                  DICPeriodCount,
+	strings.ToLower(_DICName[5208:5219]): DICPeriodCount,
+	_DICName[5219:5230]:                  DICTariffCount,
+	strings.ToLower(_DICName[5219:5230]): DICTariffCount,
+	_DICName[5230:5242]:                  DICHolidayCount,
+	strings.ToLower(_DICName[5230:5242]): DICHolidayCount,
+	_DICName[5242:5253]:                  DICMeterNumber,
+	strings.ToLower(_DICName[5242:5253]): DICMeterNumber,
+	_DICName[5253:5272]:                  DICAssetManagementCode,
+	strings.ToLower(_DICName[5253:5272]): DICAssetManagementCode,
+	_DICName[5272:5286]:                  DICActiveConstant,
+	strings.ToLower(_DICName[5272:5286]): DICActiveConstant,
+	_DICName[5286:5302]:                  DICReactiveConstant,
+	strings.ToLower(_DICName[5286:5302]): DICReactiveConstant,
+	_DICName[5302:5312]:                  DICUserNumber,
+	strings.ToLower(_DICName[5302:5312]): DICUserNumber,
+	_DICName[5312:5330]:                  DICRunningStatusWord1,
+	strings.ToLower(_DICName[5312:5330]): DICRunningStatusWord1,
+	_DICName[5330:5348]:                  DICRunningStatusWord2,
+	strings.ToLower(_DICName[5330:5348]): DICRunningStatusWord2,
+	_DICName[5348:5366]:                  DICRunningStatusWord3,
+	strings.ToLower(_DICName[5348:5366]): DICRunningStatusWord3,
+	_DICName[5366:5384]:                  DICRunningStatusWord4,
+	strings.ToLower(_DICName[5366:5384]): DICRunningStatusWord4,
+	_DICName[5384:5402]:                  DICRunningStatusWord5,
+	strings.ToLower(_DICName[5384:5402]): DICRunningStatusWord5,
+	_DICName[5402:5420]:                  DICRunningStatusWord6,
+	strings.ToLower(_DICName[5402:5420]): DICRunningStatusWord6,
+	_DICName[5420:5438]:                  DICRunningStatusWord7,
+	strings.ToLower(_DICName[5420:5438]): DICRunningStatusWord7,
+	_DICName[5438:5455]:                  DICRunningStatusWord,
+	strings.ToLower(_DICName[5438:5455]): DICRunningStatusWord,
+	_DICName[5455:5472]:                  DICAlarmAmount1Limit,
+	strings.ToLower(_DICName[5455:5472]): DICAlarmAmount1Limit,
+	_DICName[5472:5489]:                  DICAlarmAmount2Limit,
+	strings.ToLower(_DICName[5472:5489]): DICAlarmAmount2Limit,
+	_DICName[5489:5509]:                  DICOverdraftAmountLimit,
+	strings.ToLower(_DICName[5489:5509]): DICOverdraftAmountLimit,
+	_DICName[5509:5528]:                  DICHoardingAmountLimit,
+	strings.ToLower(_DICName[5509:5528]): DICHoardingAmountLimit,
+	_DICName[5528:5551]:                  DICCloseAllowedAmountLimit,
+	strings.ToLower(_DICName[5528:5551]): DICCloseAllowedAmountLimit,
+	_DICName[5551:5573]:                  DICActiveReportStatusWord,
+	strings.ToLower(_DICName[5551:5573]): DICActiveReportStatusWord,
+	_DICName[5573:5591]:                  DICFirstTimeZoneTable,
+	strings.ToLower(_DICName[5573:5591]): DICFirstTimeZoneTable,
+	_DICName[5591:5607]:                  DICFirstDailyTable1,
+	strings.ToLower(_DICName[5591:5607]): DICFirstDailyTable1,
+	_DICName[5607:5626]:                  DICSecondTimeZoneTable,
+	strings.ToLower(_DICName[5607:5626]): DICSecondTimeZoneTable,
+	_DICName[5626:5643]:                  DICSecondDailyTable1,
+	strings.ToLower(_DICName[5626:5643]): DICSecondDailyTable1,
+	_DICName[5643:5651]:                  DICHoliday1,
+	strings.ToLower(_DICName[5643:5651]): DICHoliday1,
+	_DICName[5651:5678]:                  DICPositiveTotalReactiveEnergy,
+	strings.ToLower(_DICName[5651:5678]): DICPositiveTotalReactiveEnergy,
+	_DICName[5678:5705]:                  DICPositiveReactiveEnergyRate1,
+	strings.ToLower(_DICName[5678:5705]): DICPositiveReactiveEnergyRate1,
+	_DICName[5705:5732]:                  DICPositiveReactiveEnergyRate2,
+	strings.ToLower(_DICName[5705:5732]): DICPositiveReactiveEnergyRate2,
+	_DICName[5732:5759]:                  DICPositiveReactiveEnergyRate3,
+	strings.ToLower(_DICName[5732:5759]): DICPositiveReactiveEnergyRate3,
+	_DICName[5759:5786]:                  DICPositiveReactiveEnergyRate4,
+	strings.ToLower(_DICName[5759:5786]): DICPositiveReactiveEnergyRate4,
+	_DICName[5786:5808]:                  DICPositiveReactiveEnergy,
+	strings.ToLower(_DICName[5786:5808]): DICPositiveReactiveEnergy,
+	_DICName[5808:5835]:                  DICNegativeTotalReactiveEnergy,
+	strings.ToLower(_DICName[5808:5835]): DICNegativeTotalReactiveEnergy,
+	_DICName[5835:5862]:                  DICNegativeReactiveEnergyRate1,
+	strings.ToLower(_DICName[5835:5862]): DICNegativeReactiveEnergyRate1,
+	_DICName[5862:5889]:                  DICNegativeReactiveEnergyRate2,
+	strings.ToLower(_DICName[5862:5889]): DICNegativeReactiveEnergyRate2,
+	_DICName[5889:5916]:                  DICNegativeReactiveEnergyRate3,
+	strings.ToLower(_DICName[5889:5916]): DICNegativeReactiveEnergyRate3,
+	_DICName[5916:5943]:                  DICNegativeReactiveEnergyRate4,
+	strings.ToLower(_DICName[5916:5943]): DICNegativeReactiveEnergyRate4,
+	_DICName[5943:5965]:                  DICNegativeReactiveEnergy,
+	strings.ToLower(_DICName[5943:5965]): DICNegativeReactiveEnergy,
+	_DICName[5965:5990]:                  DICPositiveReactiveMaxDemand,
+	strings.ToLower(_DICName[5965:5990]): DICPositiveReactiveMaxDemand,
+	_DICName[5990:6015]:                  DICNegativeReactiveMaxDemand,
+	strings.ToLower(_DICName[5990:6015]): DICNegativeReactiveMaxDemand,
+	_DICName[6015:6042]:                  DICPositiveActiveMaxDemandTime,
+	strings.ToLower(_DICName[6015:6042]): DICPositiveActiveMaxDemandTime,
+	_DICName[6042:6074]:                  DICPositiveActiveMaxDemandRate1Time,
+	strings.ToLower(_DICName[6042:6074]): DICPositiveActiveMaxDemandRate1Time,
+	_DICName[6074:6106]:                  DICPositiveActiveMaxDemandRate2Time,
+	strings.ToLower(_DICName[6074:6106]): DICPositiveActiveMaxDemandRate2Time,
+	_DICName[6106:6138]:                  DICPositiveActiveMaxDemandRate3Time,
+	strings.ToLower(_DICName[6106:6138]): DICPositiveActiveMaxDemandRate3Time,
+	_DICName[6138:6170]:                  DICPositiveActiveMaxDemandRate4Time,
+	strings.ToLower(_DICName[6138:6170]): DICPositiveActiveMaxDemandRate4Time,
+	_DICName[6170:6202]:                  DICPositiveActiveMaxDemandTimeBlock,
+	strings.ToLower(_DICName[6170:6202]): DICPositiveActiveMaxDemandTimeBlock,
+	_DICName[6202:6229]:                  DICNegativeActiveMaxDemandTime,
+	strings.ToLower(_DICName[6202:6229]): DICNegativeActiveMaxDemandTime,
+	_DICName[6229:6258]:                  DICPositiveReactiveMaxDemandTime,
+	strings.ToLower(_DICName[6229:6258]): DICPositiveReactiveMaxDemandTime,
+	_DICName[6258:6287]:                  DICNegativeReactiveMaxDemandTime,
+	strings.ToLower(_DICName[6258:6287]): DICNegativeReactiveMaxDemandTime,
+	_DICName[6287:6302]:                  DICLastProgramTime,
+	strings.ToLower(_DICName[6287:6302]): DICLastProgramTime,
+	_DICName[6302:6321]:                  DICLastDemandResetTime,
+	strings.ToLower(_DICName[6302:6321]): DICLastDemandResetTime,
+	_DICName[6321:6341]:                  DICTotalPhaseBreakCount,
+	strings.ToLower(_DICName[6321:6341]): DICTotalPhaseBreakCount,
+	_DICName[6341:6357]:                  DICPhaseABreakCount,
+	strings.ToLower(_DICName[6341:6357]): DICPhaseABreakCount,
+	_DICName[6357:6373]:                  DICPhaseBBreakCount,
+	strings.ToLower(_DICName[6357:6373]): DICPhaseBBreakCount,
+	_DICName[6373:6389]:                  DICPhaseCBreakCount,
+	strings.ToLower(_DICName[6373:6389]): DICPhaseCBreakCount,
+	_DICName[6389:6404]:                  DICPhaseBreakCount,
+	strings.ToLower(_DICName[6389:6404]): DICPhaseBreakCount,
+	_DICName[6404:6423]:                  DICTotalPhaseBreakTime,
+	strings.ToLower(_DICName[6404:6423]): DICTotalPhaseBreakTime,
+	_DICName[6423:6438]:                  DICPhaseABreakTime,
+	strings.ToLower(_DICName[6423:6438]): DICPhaseABreakTime,
+	_DICName[6438:6453]:                  DICPhaseBBreakTime,
+	strings.ToLower(_DICName[6438:6453]): DICPhaseBBreakTime,
+	_DICName[6453:6468]:                  DICPhaseCBreakTime,
+	strings.ToLower(_DICName[6453:6468]): DICPhaseCBreakTime,
+	_DICName[6468:6482]:                  DICPhaseBreakTime,
+	strings.ToLower(_DICName[6468:6482]): DICPhaseBreakTime,
+	_DICName[6482:6510]:                  DICTotalPhaseLastBreakStartTime,
+	strings.ToLower(_DICName[6482:6510]): DICTotalPhaseLastBreakStartTime,
+	_DICName[6510:6534]:                  DICPhaseALastBreakStartTime,
+	strings.ToLower(_DICName[6510:6534]): DICPhaseALastBreakStartTime,
+	_DICName[6534:6558]:                  DICPhaseBLastBreakStartTime,
+	strings.ToLower(_DICName[6534:6558]): DICPhaseBLastBreakStartTime,
+	_DICName[6558:6582]:                  DICPhaseCLastBreakStartTime,
+	strings.ToLower(_DICName[6558:6582]): DICPhaseCLastBreakStartTime,
+	_DICName[6582:6605]:                  DICPhaseLastBreakStartTime,
+	strings.ToLower(_DICName[6582:6605]): DICPhaseLastBreakStartTime,
+	_DICName[6605:6631]:                  DICTotalPhaseLastBreakEndTime,
+	strings.ToLower(_DICName[6605:6631]): DICTotalPhaseLastBreakEndTime,
+	_DICName[6631:6653]:                  DICPhaseALastBreakEndTime,
+	strings.ToLower(_DICName[6631:6653]): DICPhaseALastBreakEndTime,
+	_DICName[6653:6675]:                  DICPhaseBLastBreakEndTime,
+	strings.ToLower(_DICName[6653:6675]): DICPhaseBLastBreakEndTime,
+	_DICName[6675:6697]:                  DICPhaseCLastBreakEndTime,
+	strings.ToLower(_DICName[6675:6697]): DICPhaseCLastBreakEndTime,
+	_DICName[6697:6718]:                  DICPhaseLastBreakEndTime,
+	strings.ToLower(_DICName[6697:6718]): DICPhaseLastBreakEndTime,
+	_DICName[6718:6733]:                  DICMeterStatusWord,
+	strings.ToLower(_DICName[6718:6733]): DICMeterStatusWord,
+	_DICName[6733:6747]:                  DICGridStatusWord,
+	strings.ToLower(_DICName[6733:6747]): DICGridStatusWord,
 }
 
 // ParseDIC converts a string to a DIC.
@@ -932,6 +4737,143 @@ func ParseDIC(value string) (DIC, error) {
 	return DIC(0), fmt.Errorf("%s is %w", value, ErrInvalidDIC)
 }
 
+var ErrInvalidDtuEvent = errors.New("not a valid DtuEvent")
+
+var _DtuEventName = "ConnectDisconnect"
+
+var _DtuEventMapName = map[DtuEvent]string{
+	DtuEventConnect:    _DtuEventName[0:7],
+	DtuEventDisconnect: _DtuEventName[7:17],
+}
+
+// Name is the attribute of DtuEvent.
+func (x DtuEvent) Name() string {
+	if v, ok := _DtuEventMapName[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("DtuEvent(%d).Name", x)
+}
+
+// Val is the attribute of DtuEvent.
+func (x DtuEvent) Val() int {
+	return int(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DtuEvent) IsValid() bool {
+	_, ok := _DtuEventMapName[x]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (x DtuEvent) String() string {
+	return x.Name()
+}
+
+var _DtuEventNameMap = map[string]DtuEvent{
+	_DtuEventName[0:7]:                   DtuEventConnect,
+	strings.ToLower(_DtuEventName[0:7]):  DtuEventConnect,
+	_DtuEventName[7:17]:                  DtuEventDisconnect,
+	strings.ToLower(_DtuEventName[7:17]): DtuEventDisconnect,
+}
+
+// ParseDtuEvent converts a string to a DtuEvent.
+func ParseDtuEvent(value string) (DtuEvent, error) {
+	if x, ok := _DtuEventNameMap[value]; ok {
+		return x, nil
+	}
+	if x, ok := _DtuEventNameMap[strings.ToLower(value)]; ok {
+		return x, nil
+	}
+	return DtuEvent(0), fmt.Errorf("%s is %w", value, ErrInvalidDtuEvent)
+}
+
+// MarshalText implements the text marshaller method.
+func (x DtuEvent) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DtuEvent) UnmarshalText(text []byte) error {
+	val, err := ParseDtuEvent(string(text))
+	if err != nil {
+		return err
+	}
+	*x = val
+	return nil
+}
+
+var ErrInvalidEchoMode = errors.New("not a valid EchoMode")
+
+var _EchoModeName = "OffOnAuto"
+
+var _EchoModeMapName = map[EchoMode]string{
+	EchoModeOff:  _EchoModeName[0:3],
+	EchoModeOn:   _EchoModeName[3:5],
+	EchoModeAuto: _EchoModeName[5:9],
+}
+
+// Name is the attribute of EchoMode.
+func (x EchoMode) Name() string {
+	if v, ok := _EchoModeMapName[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("EchoMode(%d).Name", x)
+}
+
+// Val is the attribute of EchoMode.
+func (x EchoMode) Val() int {
+	return int(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EchoMode) IsValid() bool {
+	_, ok := _EchoModeMapName[x]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (x EchoMode) String() string {
+	return x.Name()
+}
+
+var _EchoModeNameMap = map[string]EchoMode{
+	_EchoModeName[0:3]:                  EchoModeOff,
+	strings.ToLower(_EchoModeName[0:3]): EchoModeOff,
+	_EchoModeName[3:5]:                  EchoModeOn,
+	strings.ToLower(_EchoModeName[3:5]): EchoModeOn,
+	_EchoModeName[5:9]:                  EchoModeAuto,
+	strings.ToLower(_EchoModeName[5:9]): EchoModeAuto,
+}
+
+// ParseEchoMode converts a string to an EchoMode.
+func ParseEchoMode(value string) (EchoMode, error) {
+	if x, ok := _EchoModeNameMap[value]; ok {
+		return x, nil
+	}
+	if x, ok := _EchoModeNameMap[strings.ToLower(value)]; ok {
+		return x, nil
+	}
+	return EchoMode(0), fmt.Errorf("%s is %w", value, ErrInvalidEchoMode)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EchoMode) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EchoMode) UnmarshalText(text []byte) error {
+	val, err := ParseEchoMode(string(text))
+	if err != nil {
+		return err
+	}
+	*x = val
+	return nil
+}
+
 var ErrInvalidErrorCode = errors.New("not a valid ErrorCode")
 
 var _ErrorCodeName = "RATEDAYYEARBRPDDATAOTHER"
@@ -1007,6 +4949,65 @@ func ParseErrorCode(value string) (ErrorCode, error) {
 	return ErrorCode(0), fmt.Errorf("%s is %w", value, ErrInvalidErrorCode)
 }
 
+var ErrInvalidEventKind = errors.New("not a valid EventKind")
+
+var _EventKindName = "PowerDownProgramMeterResetDemandResetEventResetClockAdjustMeterCoverOpenTerminalCoverOpen"
+
+var _EventKindMapName = map[EventKind]string{
+	EventKindPowerDown:         _EventKindName[0:9],
+	EventKindProgram:           _EventKindName[9:16],
+	EventKindMeterReset:        _EventKindName[16:26],
+	EventKindDemandReset:       _EventKindName[26:37],
+	EventKindEventReset:        _EventKindName[37:47],
+	EventKindClockAdjust:       _EventKindName[47:58],
+	EventKindMeterCoverOpen:    _EventKindName[58:72],
+	EventKindTerminalCoverOpen: _EventKindName[72:89],
+}
+
+// Name is the attribute of EventKind.
+func (x EventKind) Name() string {
+	if v, ok := _EventKindMapName[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("EventKind(%d).Name", x)
+}
+
+// Val is the attribute of EventKind.
+func (x EventKind) Val() uint32 {
+	return uint32(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EventKind) IsValid() bool {
+	_, ok := _EventKindMapName[x]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (x EventKind) String() string {
+	return x.Name()
+}
+
+var _EventKindNameMap = map[string]EventKind{
+	_EventKindName[0:9]:   EventKindPowerDown,
+	_EventKindName[9:16]:  EventKindProgram,
+	_EventKindName[16:26]: EventKindMeterReset,
+	_EventKindName[26:37]: EventKindDemandReset,
+	_EventKindName[37:47]: EventKindEventReset,
+	_EventKindName[47:58]: EventKindClockAdjust,
+	_EventKindName[58:72]: EventKindMeterCoverOpen,
+	_EventKindName[72:89]: EventKindTerminalCoverOpen,
+}
+
+// ParseEventKind converts a string to an EventKind.
+func ParseEventKind(value string) (EventKind, error) {
+	if x, ok := _EventKindNameMap[value]; ok {
+		return x, nil
+	}
+	return EventKind(0), fmt.Errorf("%s is %w", value, ErrInvalidEventKind)
+}
+
 var ErrInvalidP = errors.New("not a valid P")
 
 var _PName = "V1997V2007"
@@ -1067,6 +5068,356 @@ func ParseP(value string) (P, error) {
 	return P(0), fmt.Errorf("%s is %w", value, ErrInvalidP)
 }
 
+var ErrInvalidPoint = errors.New("not a valid Point")
+
+var _PointName = "TotalActiveEnergyPositiveTotalActiveEnergyNegativeTotalActiveEnergyTotalReactiveEnergy1TotalReactiveEnergy2FirstQuadrantReactiveEnergySecondQuadrantReactiveEnergyThirdQuadrantReactiveEnergyFourthQuadrantReactiveEnergyPositiveActiveMaxDemandNegativeActiveMaxDemandPhaseAVoltagePhaseBVoltagePhaseCVoltagePhaseACurrentPhaseBCurrentPhaseCCurrentTotalActivePowerPhaseAActivePowerPhaseBActivePowerPhaseCActivePowerTotalReactivePowerPhaseAReactivePowerPhaseBReactivePowerPhaseCReactivePowerTotalPowerFactorPhaseAPowerFactorPhaseBPowerFactorPhaseCPowerFactorFrequencyTemperatureActiveConstantReactiveConstantTotalProgramCountTotalDemandResetCountBatteryRunTime"
+
+var _PointMapName = map[Point]string{
+	PointTotalActiveEnergy:            _PointName[0:17],
+	PointPositiveTotalActiveEnergy:    _PointName[17:42],
+	PointNegativeTotalActiveEnergy:    _PointName[42:67],
+	PointTotalReactiveEnergy1:         _PointName[67:87],
+	PointTotalReactiveEnergy2:         _PointName[87:107],
+	PointFirstQuadrantReactiveEnergy:  _PointName[107:134],
+	PointSecondQuadrantReactiveEnergy: _PointName[134:162],
+	PointThirdQuadrantReactiveEnergy:  _PointName[162:189],
+	PointFourthQuadrantReactiveEnergy: _PointName[189:217],
+	PointPositiveActiveMaxDemand:      _PointName[217:240],
+	PointNegativeActiveMaxDemand:      _PointName[240:263],
+	PointPhaseAVoltage:                _PointName[263:276],
+	PointPhaseBVoltage:                _PointName[276:289],
+	PointPhaseCVoltage:                _PointName[289:302],
+	PointPhaseACurrent:                _PointName[302:315],
+	PointPhaseBCurrent:                _PointName[315:328],
+	PointPhaseCCurrent:                _PointName[328:341],
+	PointTotalActivePower:             _PointName[341:357],
+	PointPhaseAActivePower:            _PointName[357:374],
+	PointPhaseBActivePower:            _PointName[374:391],
+	PointPhaseCActivePower:            _PointName[391:408],
+	PointTotalReactivePower:           _PointName[408:426],
+	PointPhaseAReactivePower:          _PointName[426:445],
+	PointPhaseBReactivePower:          _PointName[445:464],
+	PointPhaseCReactivePower:          _PointName[464:483],
+	PointTotalPowerFactor:             _PointName[483:499],
+	PointPhaseAPowerFactor:            _PointName[499:516],
+	PointPhaseBPowerFactor:            _PointName[516:533],
+	PointPhaseCPowerFactor:            _PointName[533:550],
+	PointFrequency:                    _PointName[550:559],
+	PointTemperature:                  _PointName[559:570],
+	PointActiveConstant:               _PointName[570:584],
+	PointReactiveConstant:             _PointName[584:600],
+	PointTotalProgramCount:            _PointName[600:617],
+	PointTotalDemandResetCount:        _PointName[617:638],
+	PointBatteryRunTime:               _PointName[638:652],
+}
+
+// Name is the attribute of Point.
+func (x Point) Name() string {
+	if v, ok := _PointMapName[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("Point(%d).Name", x)
+}
+
+var _PointMapUnit = map[Point]string{
+	PointTotalActiveEnergy:            "kWh",
+	PointPositiveTotalActiveEnergy:    "kWh",
+	PointNegativeTotalActiveEnergy:    "kWh",
+	PointTotalReactiveEnergy1:         "kvarh",
+	PointTotalReactiveEnergy2:         "kvarh",
+	PointFirstQuadrantReactiveEnergy:  "kvarh",
+	PointSecondQuadrantReactiveEnergy: "kvarh",
+	PointThirdQuadrantReactiveEnergy:  "kvarh",
+	PointFourthQuadrantReactiveEnergy: "kvarh",
+	PointPositiveActiveMaxDemand:      "kW",
+	PointNegativeActiveMaxDemand:      "kW",
+	PointPhaseAVoltage:                "V",
+	PointPhaseBVoltage:                "V",
+	PointPhaseCVoltage:                "V",
+	PointPhaseACurrent:                "A",
+	PointPhaseBCurrent:                "A",
+	PointPhaseCCurrent:                "A",
+	PointTotalActivePower:             "kW",
+	PointPhaseAActivePower:            "kW",
+	PointPhaseBActivePower:            "kW",
+	PointPhaseCActivePower:            "kW",
+	PointTotalReactivePower:           "kvar",
+	PointPhaseAReactivePower:          "kvar",
+	PointPhaseBReactivePower:          "kvar",
+	PointPhaseCReactivePower:          "kvar",
+	PointTotalPowerFactor:             "",
+	PointPhaseAPowerFactor:            "",
+	PointPhaseBPowerFactor:            "",
+	PointPhaseCPowerFactor:            "",
+	PointFrequency:                    "Hz",
+	PointTemperature:                  "℃",
+	PointActiveConstant:               "imp/kWh",
+	PointReactiveConstant:             "imp/kvarh",
+	PointTotalProgramCount:            "次",
+	PointTotalDemandResetCount:        "次",
+	PointBatteryRunTime:               "分",
+}
+
+// Unit is the attribute of Point.
+func (x Point) Unit() string {
+	if v, ok := _PointMapUnit[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("Point(%d).Unit", x)
+}
+
+var _PointMapScale = map[Point]int{
+	PointTotalActiveEnergy:            2,
+	PointPositiveTotalActiveEnergy:    2,
+	PointNegativeTotalActiveEnergy:    2,
+	PointTotalReactiveEnergy1:         2,
+	PointTotalReactiveEnergy2:         2,
+	PointFirstQuadrantReactiveEnergy:  2,
+	PointSecondQuadrantReactiveEnergy: 2,
+	PointThirdQuadrantReactiveEnergy:  2,
+	PointFourthQuadrantReactiveEnergy: 2,
+	PointPositiveActiveMaxDemand:      4,
+	PointNegativeActiveMaxDemand:      4,
+	PointPhaseAVoltage:                1,
+	PointPhaseBVoltage:                1,
+	PointPhaseCVoltage:                1,
+	PointPhaseACurrent:                3,
+	PointPhaseBCurrent:                3,
+	PointPhaseCCurrent:                3,
+	PointTotalActivePower:             4,
+	PointPhaseAActivePower:            4,
+	PointPhaseBActivePower:            4,
+	PointPhaseCActivePower:            4,
+	PointTotalReactivePower:           4,
+	PointPhaseAReactivePower:          4,
+	PointPhaseBReactivePower:          4,
+	PointPhaseCReactivePower:          4,
+	PointTotalPowerFactor:             3,
+	PointPhaseAPowerFactor:            3,
+	PointPhaseBPowerFactor:            3,
+	PointPhaseCPowerFactor:            3,
+	PointFrequency:                    2,
+	PointTemperature:                  1,
+	PointActiveConstant:               0,
+	PointReactiveConstant:             0,
+	PointTotalProgramCount:            0,
+	PointTotalDemandResetCount:        0,
+	PointBatteryRunTime:               0,
+}
+
+// Scale is the attribute of Point.
+func (x Point) Scale() int {
+	if v, ok := _PointMapScale[x]; ok {
+		return v
+	}
+	return 0
+}
+
+// Val is the attribute of Point.
+func (x Point) Val() int {
+	return int(x)
+}
+
+var _PointValues = []Point{
+	PointTotalActiveEnergy,
+	PointPositiveTotalActiveEnergy,
+	PointNegativeTotalActiveEnergy,
+	PointTotalReactiveEnergy1,
+	PointTotalReactiveEnergy2,
+	PointFirstQuadrantReactiveEnergy,
+	PointSecondQuadrantReactiveEnergy,
+	PointThirdQuadrantReactiveEnergy,
+	PointFourthQuadrantReactiveEnergy,
+	PointPositiveActiveMaxDemand,
+	PointNegativeActiveMaxDemand,
+	PointPhaseAVoltage,
+	PointPhaseBVoltage,
+	PointPhaseCVoltage,
+	PointPhaseACurrent,
+	PointPhaseBCurrent,
+	PointPhaseCCurrent,
+	PointTotalActivePower,
+	PointPhaseAActivePower,
+	PointPhaseBActivePower,
+	PointPhaseCActivePower,
+	PointTotalReactivePower,
+	PointPhaseAReactivePower,
+	PointPhaseBReactivePower,
+	PointPhaseCReactivePower,
+	PointTotalPowerFactor,
+	PointPhaseAPowerFactor,
+	PointPhaseBPowerFactor,
+	PointPhaseCPowerFactor,
+	PointFrequency,
+	PointTemperature,
+	PointActiveConstant,
+	PointReactiveConstant,
+	PointTotalProgramCount,
+	PointTotalDemandResetCount,
+	PointBatteryRunTime,
+}
+
+// PointValues returns a list of the values of Point
+func PointValues() []Point {
+	return _PointValues
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Point) IsValid() bool {
+	_, ok := _PointMapName[x]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (x Point) String() string {
+	return x.Name()
+}
+
+var _PointNameMap = map[string]Point{
+	_PointName[0:17]:                     PointTotalActiveEnergy,
+	strings.ToLower(_PointName[0:17]):    PointTotalActiveEnergy,
+	_PointName[17:42]:                    PointPositiveTotalActiveEnergy,
+	strings.ToLower(_PointName[17:42]):   PointPositiveTotalActiveEnergy,
+	_PointName[42:67]:                    PointNegativeTotalActiveEnergy,
+	strings.ToLower(_PointName[42:67]):   PointNegativeTotalActiveEnergy,
+	_PointName[67:87]:                    PointTotalReactiveEnergy1,
+	strings.ToLower(_PointName[67:87]):   PointTotalReactiveEnergy1,
+	_PointName[87:107]:                   PointTotalReactiveEnergy2,
+	strings.ToLower(_PointName[87:107]):  PointTotalReactiveEnergy2,
+	_PointName[107:134]:                  PointFirstQuadrantReactiveEnergy,
+	strings.ToLower(_PointName[107:134]): PointFirstQuadrantReactiveEnergy,
+	_PointName[134:162]:                  PointSecondQuadrantReactiveEnergy,
+	strings.ToLower(_PointName[134:162]): PointSecondQuadrantReactiveEnergy,
+	_PointName[162:189]:                  PointThirdQuadrantReactiveEnergy,
+	strings.ToLower(_PointName[162:189]): PointThirdQuadrantReactiveEnergy,
+	_PointName[189:217]:                  PointFourthQuadrantReactiveEnergy,
+	strings.ToLower(_PointName[189:217]): PointFourthQuadrantReactiveEnergy,
+	_PointName[217:240]:                  PointPositiveActiveMaxDemand,
+	strings.ToLower(_PointName[217:240]): PointPositiveActiveMaxDemand,
+	_PointName[240:263]:                  PointNegativeActiveMaxDemand,
+	strings.ToLower(_PointName[240:263]): PointNegativeActiveMaxDemand,
+	_PointName[263:276]:                  PointPhaseAVoltage,
+	strings.ToLower(_PointName[263:276]): PointPhaseAVoltage,
+	_PointName[276:289]:                  PointPhaseBVoltage,
+	strings.ToLower(_PointName[276:289]): PointPhaseBVoltage,
+	_PointName[289:302]:                  PointPhaseCVoltage,
+	strings.ToLower(_PointName[289:302]): PointPhaseCVoltage,
+	_PointName[302:315]:                  PointPhaseACurrent,
+	strings.ToLower(_PointName[302:315]): PointPhaseACurrent,
+	_PointName[315:328]:                  PointPhaseBCurrent,
+	strings.ToLower(_PointName[315:328]): PointPhaseBCurrent,
+	_PointName[328:341]:                  PointPhaseCCurrent,
+	strings.ToLower(_PointName[328:341]): PointPhaseCCurrent,
+	_PointName[341:357]:                  PointTotalActivePower,
+	strings.ToLower(_PointName[341:357]): PointTotalActivePower,
+	_PointName[357:374]:                  PointPhaseAActivePower,
+	strings.ToLower(_PointName[357:374]): PointPhaseAActivePower,
+	_PointName[374:391]:                  PointPhaseBActivePower,
+	strings.ToLower(_PointName[374:391]): PointPhaseBActivePower,
+	_PointName[391:408]:                  PointPhaseCActivePower,
+	strings.ToLower(_PointName[391:408]): PointPhaseCActivePower,
+	_PointName[408:426]:                  PointTotalReactivePower,
+	strings.ToLower(_PointName[408:426]): PointTotalReactivePower,
+	_PointName[426:445]:                  PointPhaseAReactivePower,
+	strings.ToLower(_PointName[426:445]): PointPhaseAReactivePower,
+	_PointName[445:464]:                  PointPhaseBReactivePower,
+	strings.ToLower(_PointName[445:464]): PointPhaseBReactivePower,
+	_PointName[464:483]:                  PointPhaseCReactivePower,
+	strings.ToLower(_PointName[464:483]): PointPhaseCReactivePower,
+	_PointName[483:499]:                  PointTotalPowerFactor,
+	strings.ToLower(_PointName[483:499]): PointTotalPowerFactor,
+	_PointName[499:516]:                  PointPhaseAPowerFactor,
+	strings.ToLower(_PointName[499:516]): PointPhaseAPowerFactor,
+	_PointName[516:533]:                  PointPhaseBPowerFactor,
+	strings.ToLower(_PointName[516:533]): PointPhaseBPowerFactor,
+	_PointName[533:550]:                  PointPhaseCPowerFactor,
+	strings.ToLower(_PointName[533:550]): PointPhaseCPowerFactor,
+	_PointName[550:559]:                  PointFrequency,
+	strings.ToLower(_PointName[550:559]): PointFrequency,
+	_PointName[559:570]:                  PointTemperature,
+	strings.ToLower(_PointName[559:570]): PointTemperature,
+	_PointName[570:584]:                  PointActiveConstant,
+	strings.ToLower(_PointName[570:584]): PointActiveConstant,
+	_PointName[584:600]:                  PointReactiveConstant,
+	strings.ToLower(_PointName[584:600]): PointReactiveConstant,
+	_PointName[600:617]:                  PointTotalProgramCount,
+	strings.ToLower(_PointName[600:617]): PointTotalProgramCount,
+	_PointName[617:638]:                  PointTotalDemandResetCount,
+	strings.ToLower(_PointName[617:638]): PointTotalDemandResetCount,
+	_PointName[638:652]:                  PointBatteryRunTime,
+	strings.ToLower(_PointName[638:652]): PointBatteryRunTime,
+}
+
+// ParsePoint converts a string to a Point.
+func ParsePoint(value string) (Point, error) {
+	if x, ok := _PointNameMap[value]; ok {
+		return x, nil
+	}
+	if x, ok := _PointNameMap[strings.ToLower(value)]; ok {
+		return x, nil
+	}
+	return Point(0), fmt.Errorf("%s is %w", value, ErrInvalidPoint)
+}
+
+var ErrInvalidPriority = errors.New("not a valid Priority")
+
+var _PriorityName = "BackgroundNormalInteractiveControl"
+
+var _PriorityMapName = map[Priority]string{
+	PriorityBackground:  _PriorityName[0:10],
+	PriorityNormal:      _PriorityName[10:16],
+	PriorityInteractive: _PriorityName[16:27],
+	PriorityControl:     _PriorityName[27:34],
+}
+
+// Name is the attribute of Priority.
+func (x Priority) Name() string {
+	if v, ok := _PriorityMapName[x]; ok {
+		return v
+	}
+	return fmt.Sprintf("Priority(%d).Name", x)
+}
+
+// Val is the attribute of Priority.
+func (x Priority) Val() int {
+	return int(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Priority) IsValid() bool {
+	_, ok := _PriorityMapName[x]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (x Priority) String() string {
+	return x.Name()
+}
+
+var _PriorityNameMap = map[string]Priority{
+	_PriorityName[0:10]:                   PriorityBackground,
+	strings.ToLower(_PriorityName[0:10]):  PriorityBackground,
+	_PriorityName[10:16]:                  PriorityNormal,
+	strings.ToLower(_PriorityName[10:16]): PriorityNormal,
+	_PriorityName[16:27]:                  PriorityInteractive,
+	strings.ToLower(_PriorityName[16:27]): PriorityInteractive,
+	_PriorityName[27:34]:                  PriorityControl,
+	strings.ToLower(_PriorityName[27:34]): PriorityControl,
+}
+
+// ParsePriority converts a string to a Priority.
+func ParsePriority(value string) (Priority, error) {
+	if x, ok := _PriorityNameMap[value]; ok {
+		return x, nil
+	}
+	if x, ok := _PriorityNameMap[strings.ToLower(value)]; ok {
+		return x, nil
+	}
+	return Priority(0), fmt.Errorf("%s is %w", value, ErrInvalidPriority)
+}
+
 var ErrInvalidState = errors.New("not a valid State")
 
 var _StateName = "UnknownConnectingConnectedDisconnectedConnectClosed"
@@ -0,0 +1,44 @@
+package dlt645
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// detectDIC the dic supported by all the meters of 1997 and 2007
+const detectDIC = DICPhaseAVoltage
+
+// probe return true if the meter response the normal response of the read request of the protocol.
+// The error response is not accepted, the 1997 meter may reply the error response with the same control code to the
+// unknown 2007 read request, which can not be told from the error response of the 2007 meter.
+func (c *client) probe(ctx context.Context, addr string, protocol P) (bool, error) {
+	if _, err := c.readProtocolData(ctx, addr, detectDIC, protocol); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DetectProtocol probe the meter with the 2007 read and then the 1997 read, the result is cached for the address.
+// The meter must support reading the phase A voltage.
+func (c *client) DetectProtocol(addr string) (P, error) {
+	return c.DetectProtocolContext(context.Background(), addr)
+}
+
+func (c *client) DetectProtocolContext(ctx context.Context, addr string) (P, error) {
+	var errs []error
+	for _, protocol := range []P{PV2007, PV1997} {
+		ok, err := c.probe(ctx, addr, protocol)
+		if ok {
+			c.protoLock.Lock()
+			c.protocols[addr] = protocol
+			c.protoLock.Unlock()
+
+			c.L.Infof("meter %s protocol is %s", addr, protocol)
+			return protocol, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", protocol, err))
+	}
+
+	return c.Protocol, fmt.Errorf("detect protocol of %s failed: %w", addr, errors.Join(errs...))
+}
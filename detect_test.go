@@ -0,0 +1,47 @@
+package dlt645
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// meter1997 response the 1997 read request of the phase A voltage, and the error response to the 2007 read request
+func meter1997(req []byte) [][]byte {
+	req = bytes.TrimLeft(req, string([]byte{PRE_BYTE}))
+	switch Code(req[8]) {
+	case 0x01:
+		return [][]byte{testFrame("1", 0x81, append(DICPhaseAVoltage.Code(PV1997), 0x30, 0x02), 0)}
+	case 0x11:
+		return [][]byte{testFrame("1", 0xD1, []byte{byte(ErrorCodeOTHER)}, 0)}
+	}
+	return nil
+}
+
+func TestDetect_DetectProtocol(t *testing.T) {
+	tr := newTestTransporter(t, meter1997)
+	c := NewClient(tr, WithTimeout(50*time.Millisecond))
+
+	p, err := c.DetectProtocol("1")
+	assert.NoError(t, err)
+	assert.Equal(t, PV1997, p)
+
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	// 只有异常应答时无法确定协议
+	tr.Handle(func(req []byte) [][]byte {
+		req = bytes.TrimLeft(req, string([]byte{PRE_BYTE}))
+		return [][]byte{testFrame("2", Code(0xC0|req[8]), []byte{byte(ErrorCodeDATA)}, 4)}
+	})
+	_, err = c.DetectProtocol("2")
+	assert.True(t, errors.Is(err, ErrorCodeDATA))
+
+	tr.Handle(noResponse)
+	_, err = c.DetectProtocol("3")
+	assert.Error(t, err)
+}
@@ -0,0 +1,184 @@
+package dlt645
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DICField the field layout of the record dic, the fields are in the order of the data
+type DICField struct {
+	Name   string `yaml:"name" json:"name"`
+	Format string `yaml:"format" json:"format"`
+	Size   int    `yaml:"size" json:"size"`
+	Unit   string `yaml:"unit" json:"unit"`
+	Signed bool   `yaml:"signed" json:"signed"`
+}
+
+// DICEntry the dic of the data dictionary file, the codes are hex string like "0x04800101" or "E011".
+// Code is the 2007 code, Old is the 1997 code, at least one of them must be set.
+type DICEntry struct {
+	Name      string     `yaml:"name" json:"name"`
+	Code      string     `yaml:"code" json:"code"`
+	Format    string     `yaml:"format" json:"format"`
+	Size      int        `yaml:"size" json:"size"`
+	Old       string     `yaml:"old" json:"old"`
+	OldFormat string     `yaml:"oldFormat" json:"oldFormat"`
+	OldSize   int        `yaml:"oldSize" json:"oldSize"`
+	Unit      string     `yaml:"unit" json:"unit"`
+	Signed    bool       `yaml:"signed" json:"signed"`
+	Fields    []DICField `yaml:"fields" json:"fields"` // 记录的字段, 设置后读取结果的Data为[]*Value
+}
+
+// DICDictionary the data dictionary file of the dics
+type DICDictionary struct {
+	DICs []DICEntry `yaml:"dics" json:"dics"`
+}
+
+func parseHexCode(s string, bitSize int) (uint32, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		s = s[2:]
+	}
+
+	v, err := strconv.ParseUint(s, 16, bitSize)
+	return uint32(v), err
+}
+
+// definitions convert the entry to the dic definitions of 2007 and 1997
+func (e *DICEntry) definitions() (defs []DICDefinition, err error) {
+	if e.Code == "" && e.Old == "" {
+		return nil, fmt.Errorf("%s has no code", e.Name)
+	}
+
+	if e.Code != "" {
+		d := DICDefinition{Name: e.Name, Protocol: PV2007, Format: e.Format, Size: e.Size, Unit: e.Unit, Signed: e.Signed}
+		if d.Code, err = parseHexCode(e.Code, 32); err != nil {
+			return nil, fmt.Errorf("%s code %s is invalid: %w", e.Name, e.Code, err)
+		}
+		defs = append(defs, d)
+	}
+
+	if e.Old != "" {
+		d := DICDefinition{Name: e.Name, Protocol: PV1997, Format: e.OldFormat, Size: e.OldSize, Unit: e.Unit, Signed: e.Signed}
+		if d.Code, err = parseHexCode(e.Old, 16); err != nil {
+			return nil, fmt.Errorf("%s old code %s is invalid: %w", e.Name, e.Old, err)
+		}
+		defs = append(defs, d)
+	}
+
+	for _, d := range defs {
+		if err = d.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(e.Fields) > 0 {
+		size := 0
+		for _, f := range e.Fields {
+			if f.Size <= 0 {
+				return nil, fmt.Errorf("%s field %s size must be more than 0", e.Name, f.Name)
+			}
+			size += f.Size
+		}
+
+		for _, d := range defs {
+			if size > d.Size {
+				return nil, fmt.Errorf("%s fields size %d is more than %d", e.Name, size, d.Size)
+			}
+		}
+	}
+
+	return defs, nil
+}
+
+// decodeFieldsFunc return the decoder of the record dic, which decode the data to the values of the fields
+func decodeFieldsFunc(fields []DICField) func(buf []byte) (any, error) {
+	return func(buf []byte) (any, error) {
+		var values []*Value
+		for _, f := range fields {
+			if len(buf) < f.Size {
+				return values, fmt.Errorf("field %s data length %d is less than %d", f.Name, len(buf), f.Size)
+			}
+
+			values = append(values, &Value{
+				Name:  f.Name,
+				Unit:  f.Unit,
+				Value: decodeBcd(buf, f.Format, f.Size, f.Signed),
+				Raw:   buf[:f.Size],
+			})
+			buf = buf[f.Size:]
+		}
+
+		return values, nil
+	}
+}
+
+// Register all the dics of the dictionary, return the registered dics.
+// The dictionary is registered atomically, none of the dics is registered if any of them is invalid or registered.
+func (d *DICDictionary) Register() (dics []DIC, err error) {
+	entries := make([][]DICDefinition, len(d.DICs))
+	for i := range d.DICs {
+		if entries[i], err = d.DICs[i].definitions(); err != nil {
+			return nil, err
+		}
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	staged := registry.clone()
+	for i, defs := range entries {
+		var dic DIC
+		for _, def := range defs {
+			if dic, err = staged.register(def); err != nil {
+				return nil, err
+			}
+		}
+
+		if fields := d.DICs[i].Fields; len(fields) > 0 {
+			staged.decoders[dic] = decodeFieldsFunc(fields)
+		}
+
+		dics = append(dics, dic)
+	}
+
+	registry.commit(staged)
+	return dics, nil
+}
+
+// LoadDICYAML load and register the dics of the yaml data dictionary
+func LoadDICYAML(data []byte) ([]DIC, error) {
+	d := &DICDictionary{}
+	if err := yaml.Unmarshal(data, d); err != nil {
+		return nil, err
+	}
+	return d.Register()
+}
+
+// LoadDICJSON load and register the dics of the json data dictionary
+func LoadDICJSON(data []byte) ([]DIC, error) {
+	d := &DICDictionary{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, err
+	}
+	return d.Register()
+}
+
+// LoadDICFile load and register the dics of the data dictionary file, the .json file is json, others are yaml
+func LoadDICFile(path string) ([]DIC, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return LoadDICJSON(data)
+	}
+	return LoadDICYAML(data)
+}
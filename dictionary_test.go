@@ -0,0 +1,83 @@
+package dlt645
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDictionaryYAML = `
+dics:
+  - name: VendorTotalEnergy
+    code: "0x04800201"
+    format: XXXXXX.XX
+    size: 4
+    old: "E021"
+    oldFormat: XXXXXX.XX
+    oldSize: 4
+    unit: kWh
+  - name: VendorOverloadRecord
+    code: "04800202"
+    size: 8
+    fields:
+      - name: Count
+        format: XXXX
+        size: 2
+        unit: 次
+      - name: Power
+        format: XX.XXXX
+        size: 3
+        unit: kW
+        signed: true
+`
+
+func TestDictionary_LoadDICYAML(t *testing.T) {
+	resetRegistry(t)
+
+	dics, err := LoadDICYAML([]byte(testDictionaryYAML))
+	assert.NoError(t, err)
+	assert.Equal(t, []DIC{0x04800201, 0x04800202}, dics)
+	assert.Equal(t, []byte{0x21, 0xE0}, dics[0].Code(PV1997))
+
+	c := &client{}
+	values := c.getValue([]byte{0x34, 0x12, 0x56, 0x34, 0x92, 0x00, 0x00, 0x00}, dics[1], PV2007)
+	assert.Len(t, values, 1)
+	assert.NoError(t, values[0].Err)
+
+	fields := values[0].Data.([]*Value)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "1234次", fields[0].Value.String()+fields[0].Unit)
+	assert.Equal(t, "-12.3456kW", fields[1].Value.String()+fields[1].Unit)
+
+	// 已经注册过的数据标识
+	_, err = LoadDICYAML([]byte(testDictionaryYAML))
+	assert.Error(t, err)
+
+	// 有冲突的字典不注册任何数据标识
+	_, err = LoadDICJSON([]byte(`{"dics": [{"name": "VendorNew", "code": "0x04800203", "size": 2}, {"name": "VendorTotalEnergy", "code": "0x04800204", "size": 2}]}`))
+	assert.Error(t, err)
+	_, ok := parseDIC("VendorNew")
+	assert.False(t, ok)
+	assert.False(t, DIC(0x04800203).isKnown())
+}
+
+func TestDictionary_LoadDICFile(t *testing.T) {
+	resetRegistry(t)
+
+	path := filepath.Join(t.TempDir(), "dics.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"dics": [{"name": "VendorFrequency", "code": "0x04800301", "format": "XX.XX", "size": 2, "unit": "Hz"}]}`), 0o644))
+
+	dics, err := LoadDICFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []DIC{0x04800301}, dics)
+	assert.Equal(t, "Hz", dics[0].attr().unit)
+
+	_, err = LoadDICJSON([]byte(`{"dics": [{"name": "VendorNoCode", "size": 2}]}`))
+	assert.Error(t, err)
+	_, err = LoadDICJSON([]byte(`{"dics": [{"name": "VendorBadCode", "code": "0xZZ", "size": 2}]}`))
+	assert.Error(t, err)
+	_, err = LoadDICJSON([]byte(`{"dics": [{"name": "VendorBadFields", "code": "0x04800302", "size": 2, "fields": [{"name": "A", "size": 3}]}]}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package dlt645
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const MaxEventRecords = 10 // 事件记录最多保存上10次
+
+/*
+EventKind 事件记录类型, the value is the 2007 total count code, value + N is the last N record code
+
+	@Enum {
+		PowerDown         = 0x03110000 // 掉电
+		Program           = 0x03300000 // 编程
+		MeterReset        = 0x03300100 // 电表清零
+		DemandReset       = 0x03300200 // 需量清零
+		EventReset        = 0x03300300 // 事件清零
+		ClockAdjust       = 0x03300400 // 校时
+		MeterCoverOpen    = 0x03300D00 // 开表盖
+		TerminalCoverOpen = 0x03300E00 // 开端钮盒
+	}
+*/
+type EventKind uint32
+
+// CountDIC return the total count dic of the event
+func (k EventKind) CountDIC() DIC {
+	return DIC(k.Val())
+}
+
+// RecordDIC return the last n record dic of the event, n is from 1 to MaxEventRecords
+func (k EventKind) RecordDIC(n int) DIC {
+	return DIC(k.Val() + uint32(n))
+}
+
+// EventRecord 事件记录, 不同类型的事件只填充自己包含的字段
+type EventRecord struct {
+	Kind     EventKind
+	Seq      int       // 上N次记录
+	Start    time.Time // 发生时刻
+	End      time.Time // 结束时刻
+	Operator string    // 操作者代码
+	Before   time.Time // 校时前时间
+	After    time.Time // 校时后时间
+	DICs     []DIC     // 编程的数据标识, 事件清零的数据标识
+	Raw      []byte    // 记录的原始数据
+}
+
+func decodeOperator(buf []byte) string {
+	return fmt.Sprintf("%08X", binary.LittleEndian.Uint32(buf))
+}
+
+func decodeDICs(buf []byte) (ret []DIC) {
+	for i := 0; i+4 <= len(buf); i += 4 {
+		v := binary.LittleEndian.Uint32(buf[i:])
+		if v == 0xFFFFFFFF || v == 0 {
+			continue
+		}
+		ret = append(ret, DIC(v))
+	}
+	return ret
+}
+
+func parseEventRecord(kind EventKind, seq int, buf []byte, loc *time.Location) (r *EventRecord, err error) {
+	size := kind.RecordDIC(1).Size(PV2007)
+	if len(buf) < size {
+		return nil, fmt.Errorf("%s record length %d is less than %d", kind, len(buf), size)
+	}
+
+	r = &EventRecord{Kind: kind, Seq: seq, Raw: buf[:size]}
+
+	switch kind {
+	case EventKindPowerDown, EventKindMeterCoverOpen, EventKindTerminalCoverOpen:
+		if r.Start, err = bcdToTime(buf[0:6], loc); err != nil {
+			return nil, err
+		}
+		if r.End, err = bcdToTime(buf[6:12], loc); err != nil {
+			return nil, err
+		}
+	case EventKindProgram, EventKindMeterReset, EventKindDemandReset, EventKindEventReset:
+		if r.Start, err = bcdToTime(buf[0:6], loc); err != nil {
+			return nil, err
+		}
+		r.Operator = decodeOperator(buf[6:10])
+		if kind == EventKindProgram {
+			r.DICs = decodeDICs(buf[10:50])
+		} else if kind == EventKindEventReset {
+			r.DICs = []DIC{DIC(binary.LittleEndian.Uint32(buf[10:14]))}
+		}
+	case EventKindClockAdjust:
+		r.Operator = decodeOperator(buf[0:4])
+		if r.Before, err = bcdToTime(buf[4:10], loc); err != nil {
+			return nil, err
+		}
+		if r.After, err = bcdToTime(buf[10:16], loc); err != nil {
+			return nil, err
+		}
+		r.Start = r.After
+	default:
+		return nil, fmt.Errorf("unsupported event kind: %s", kind)
+	}
+
+	return r, nil
+}
+
+func isEmptyRecord(buf []byte) bool {
+	for _, b := range buf {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *client) ReadEvents(addr string, kind EventKind, lastN int) (records []*EventRecord, err error) {
+	if c.protocol(addr) != PV2007 {
+		return nil, errors.New("1997 unsupport event records")
+	}
+
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("%d is %w", kind, ErrInvalidEventKind)
+	}
+
+	if lastN < 1 || lastN > MaxEventRecords {
+		return nil, fmt.Errorf("lastN must be between 1 and %d", MaxEventRecords)
+	}
+
+	for n := 1; n <= lastN; n++ {
+		data, err1 := c.readData(addr, kind.RecordDIC(n))
+		if err1 != nil {
+			return records, err1
+		}
+
+		// 没有发生过的事件记录全为0
+		if isEmptyRecord(data) {
+			break
+		}
+
+		r, err1 := parseEventRecord(kind, n, data, c.location)
+		if err1 != nil {
+			return records, err1
+		}
+
+		records = append(records, r)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,82 @@
+package dlt645
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_parseEventRecord(t *testing.T) {
+	tests := []struct {
+		name string
+		kind EventKind
+		buf  []byte
+		exp  *EventRecord
+	}{
+		{
+			name: "PowerDown",
+			kind: EventKindPowerDown,
+			buf:  []byte{0x30, 0x20, 0x10, 0x15, 0x07, 0x24, 0x00, 0x25, 0x10, 0x15, 0x07, 0x24},
+			exp: &EventRecord{
+				Kind:  EventKindPowerDown,
+				Seq:   1,
+				Start: time.Date(2024, 7, 15, 10, 20, 30, 0, time.UTC),
+				End:   time.Date(2024, 7, 15, 10, 25, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "EventReset",
+			kind: EventKindEventReset,
+			buf:  []byte{0x00, 0x00, 0x12, 0x01, 0x08, 0x24, 0x78, 0x56, 0x34, 0x12, 0xFF, 0xFF, 0xFF, 0xFF},
+			exp: &EventRecord{
+				Kind:     EventKindEventReset,
+				Seq:      1,
+				Start:    time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC),
+				Operator: "12345678",
+				DICs:     []DIC{DIC(0xFFFFFFFF)},
+			},
+		},
+		{
+			name: "ClockAdjust",
+			kind: EventKindClockAdjust,
+			buf:  []byte{0x01, 0x00, 0x00, 0x00, 0x59, 0x59, 0x23, 0x31, 0x12, 0x23, 0x02, 0x00, 0x00, 0x01, 0x01, 0x24},
+			exp: &EventRecord{
+				Kind:     EventKindClockAdjust,
+				Seq:      1,
+				Start:    time.Date(2024, 1, 1, 0, 0, 2, 0, time.UTC),
+				Operator: "00000001",
+				Before:   time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+				After:    time.Date(2024, 1, 1, 0, 0, 2, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseEventRecord(tt.kind, 1, tt.buf, time.UTC)
+			assert.NoError(t, err)
+			tt.exp.Raw = tt.buf
+			assert.Equal(t, tt.exp, r)
+		})
+	}
+}
+
+func TestEvent_parseProgramRecord(t *testing.T) {
+	buf := make([]byte, 50)
+	copy(buf, []byte{0x00, 0x30, 0x08, 0x20, 0x09, 0x24, 0x01, 0x00, 0x00, 0x00})
+	copy(buf[10:], []byte{0x01, 0x01, 0x00, 0x04, 0x02, 0x01, 0x00, 0x04})
+	for i := 18; i < len(buf); i++ {
+		buf[i] = 0xFF
+	}
+
+	r, err := parseEventRecord(EventKindProgram, 2, buf, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.Seq)
+	assert.Equal(t, time.Date(2024, 9, 20, 8, 30, 0, 0, time.UTC), r.Start)
+	assert.Equal(t, "00000001", r.Operator)
+	assert.Equal(t, []DIC{DICDateTime, DICTime}, r.DICs)
+
+	_, err = parseEventRecord(EventKindProgram, 1, buf[:20], time.UTC)
+	assert.Error(t, err)
+}
@@ -1,10 +1,11 @@
 package dlt645
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"github.com/expgo/factory"
-	"github.com/shopspring/decimal"
+	"strconv"
 	"strings"
 )
 
@@ -17,6 +18,7 @@ const (
 
 	PRE_BYTE     byte = 0xFE
 	PRE_BYTE_LEN      = 4
+	MaxPreambles      = 16 // 应答帧最多允许的前导字节数
 )
 
 type Code byte
@@ -39,6 +41,36 @@ func (c Code) HasMore() bool {
 	return c&(1<<5) != 0
 }
 
+// ErrCS the cs of the frame is wrong, usually caused by the noise of the bus
+var ErrCS = errors.New("cs error")
+
+// ErrMismatch the response frame is not the response of the request, such as the late response of the previous request
+var ErrMismatch = errors.New("response mismatch")
+
+// FrameError 电表的异常应答, 可以使用 errors.Is(err, ErrorCodeYEAR) 判断错误类型
+type FrameError struct {
+	Code ErrorCode
+}
+
+func (e *FrameError) Error() string {
+	if e.Code.IsValid() {
+		return fmt.Sprintf("frame has error: %s", e.Code.Msg())
+	}
+
+	var msgs []string
+	for _, code := range []ErrorCode{ErrorCodeRATE, ErrorCodeDAY, ErrorCodeYEAR, ErrorCodeBR, ErrorCodePD, ErrorCodeDATA, ErrorCodeOTHER} {
+		if e.Code&code != 0 {
+			msgs = append(msgs, code.Msg())
+		}
+	}
+	return fmt.Sprintf("frame has error: %s", strings.Join(msgs, ","))
+}
+
+func (e *FrameError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.Code&code != 0
+}
+
 type Frame struct {
 	Start   byte    `value:"0x68"` // 帧起始符
 	Address [6]byte // 地址域
@@ -113,17 +145,10 @@ func (f *Frame) DataCleanMask() {
 
 func (f *Frame) GetValue(buf []byte, dic DIC, protocol P) *Value {
 	ret := &Value{}
-	ret.Name = dic.Name()
-	ret.Unit = dic.Unit()
-
-	dotIndex := strings.Index(dic.Format(protocol), ".")
-	value := bcdToUint(buf, dic.Size(protocol))
-	if dotIndex == -1 {
-		ret.Value = decimal.NewFromUint64(value)
-	} else {
-		exp := int32(dic.Size(protocol)*2 - dotIndex)
-		ret.Value = decimal.New(int64(value), -exp)
-	}
+	a := dic.attr()
+	ret.Name = a.name
+	ret.Unit = a.unit
+	ret.Value = dic.Decode(buf, protocol)
 
 	return ret
 }
@@ -171,7 +196,7 @@ func (f *Frame) CheckStartError() error {
 
 func (f *Frame) CheckEndError() error {
 	if f.CS != f._CalcCS() {
-		return errors.New("cs error")
+		return ErrCS
 	}
 
 	if f.End != FrameEndByte {
@@ -182,8 +207,7 @@ func (f *Frame) CheckEndError() error {
 
 	if f.C.HasError() {
 		if f.L == 1 {
-			errCode := ErrorCode(f.Data[0])
-			return fmt.Errorf("frame has error: %s", errCode.Msg())
+			return &FrameError{Code: ErrorCode(f.Data[0])}
 		} else {
 			return errors.New("frame has error, but data length not equals 1")
 		}
@@ -192,9 +216,61 @@ func (f *Frame) CheckEndError() error {
 	return nil
 }
 
+// MatchResponse check the resp is the response of the request frame f, which has the response bit,
+// the same function code and the address of the request. the normal response must start with echo,
+// such as the dic code of the read request
+func (f *Frame) MatchResponse(resp *Frame, echo []byte) error {
+	if !resp.C.IsResponse() {
+		return fmt.Errorf("%w: control code %02X is not response", ErrMismatch, byte(resp.C))
+	}
+
+	if resp.C&0x1F != f.C&0x1F {
+		return fmt.Errorf("%w: control code %02X of request %02X", ErrMismatch, byte(resp.C), byte(f.C))
+	}
+
+	if !f.matchAddress(resp.Address) {
+		return fmt.Errorf("%w: address %s of request %s", ErrMismatch, resp.GetAddress(), f.GetAddress())
+	}
+
+	if !resp.C.HasError() && !bytes.HasPrefix(resp.Data, echo) {
+		return fmt.Errorf("%w: data % X not start with % X", ErrMismatch, resp.Data, echo)
+	}
+
+	return nil
+}
+
+// matchAddress the broadcast address and the 0xA nibble of the compressed address match any address
+func (f *Frame) matchAddress(addr [6]byte) bool {
+	broadcast := true
+	for _, b := range f.Address {
+		if b != 0x99 {
+			broadcast = false
+			break
+		}
+	}
+	if broadcast {
+		return true
+	}
+
+	for i, b := range f.Address {
+		if b&0xF0 != 0xA0 && b&0xF0 != addr[i]&0xF0 {
+			return false
+		}
+		if b&0x0F != 0x0A && b&0x0F != addr[i]&0x0F {
+			return false
+		}
+	}
+
+	return true
+}
+
 func NewReadFrame(addr string, dic DIC, protocol P) (*Frame, error) {
+	if !dic.Supported(protocol) {
+		return nil, fmt.Errorf("%s unsupport %s", protocol, dic.name())
+	}
+
 	f := factory.New[Frame]()
-	f.C = NewCode(CRD)
+	f.C = Code(CRD.Value(protocol))
 	if err := f.SetAddress(addr, false); err != nil {
 		return nil, err
 	}
@@ -207,23 +283,84 @@ func NewReadFrame(addr string, dic DIC, protocol P) (*Frame, error) {
 	return f, nil
 }
 
-func NewFrameByRespHeader(header []byte) (*Frame, error) {
-	if len(header) != FRAME_HEADER_LEN+PRE_BYTE_LEN {
-		return nil, errors.New("header buffer length is not equal to 10")
+// Password 写数据的密码权限及操作者代码
+type Password struct {
+	Level    byte   // 密码权限
+	Password string // 6位密码
+	Operator string // 8位操作者代码, 1997不使用
+}
+
+func (p *Password) bytes(protocol P) ([]byte, error) {
+	if p == nil {
+		return nil, errors.New("password is nil")
 	}
 
-	for i := 0; i < PRE_BYTE_LEN; i++ {
-		if header[i] != PRE_BYTE {
-			return nil, errors.New("header buffer prefix is not equal to PRE_BYTE")
+	pwd, err := strconv.ParseUint(p.Password, 10, 64)
+	if err != nil || len(p.Password) > 6 {
+		return nil, fmt.Errorf("invalid password: %s", p.Password)
+	}
+
+	ret := append([]byte{p.Level}, uintToBcd(pwd, 3)...)
+	if protocol == PV2007 {
+		operator := uint64(0)
+		if p.Operator != "" {
+			if operator, err = strconv.ParseUint(p.Operator, 10, 64); err != nil || len(p.Operator) > 8 {
+				return nil, fmt.Errorf("invalid operator code: %s", p.Operator)
+			}
 		}
+		ret = append(ret, uintToBcd(operator, 4)...)
+	}
+
+	return ret, nil
+}
+
+func NewWriteFrame(addr string, dic DIC, protocol P, password *Password, data []byte) (*Frame, error) {
+	if !dic.Supported(protocol) {
+		return nil, fmt.Errorf("%s unsupport %s", protocol, dic.name())
+	}
+
+	f := factory.New[Frame]()
+	f.C = Code(CWR.Value(protocol))
+	if err := f.SetAddress(addr, false); err != nil {
+		return nil, err
+	}
+
+	pwd, err := password.bytes(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Data = append(dic.Code(protocol), pwd...)
+	f.Data = append(f.Data, data...)
+
+	maxLen := MaxWriteLen2007
+	if protocol == PV1997 {
+		maxLen = MaxWriteLen1997
+	}
+	if len(f.Data) > maxLen {
+		return nil, fmt.Errorf("write data length %d is more than %d", len(f.Data), maxLen)
+	}
+
+	f.L = byte(len(f.Data))
+	f.DataAddMask()
+	f.CalcCS()
+
+	return f, nil
+}
+
+// NewFrameByRespHeader parse the frame header, the header can start with any count of preamble bytes
+func NewFrameByRespHeader(header []byte) (*Frame, error) {
+	header = bytes.TrimLeft(header, string([]byte{PRE_BYTE}))
+	if len(header) != FRAME_HEADER_LEN {
+		return nil, fmt.Errorf("header buffer length without preamble is not equal to %d", FRAME_HEADER_LEN)
 	}
 
 	f := &Frame{
-		Start:   header[PRE_BYTE_LEN],
-		Address: [6]byte{header[PRE_BYTE_LEN+1], header[PRE_BYTE_LEN+2], header[PRE_BYTE_LEN+3], header[PRE_BYTE_LEN+4], header[PRE_BYTE_LEN+5], header[PRE_BYTE_LEN+6]},
-		AddrEnd: header[PRE_BYTE_LEN+7],
-		C:       Code(header[PRE_BYTE_LEN+8]),
-		L:       header[PRE_BYTE_LEN+9],
+		Start:   header[0],
+		Address: [6]byte{header[1], header[2], header[3], header[4], header[5], header[6]},
+		AddrEnd: header[7],
+		C:       Code(header[8]),
+		L:       header[9],
 	}
 
 	return f, nil
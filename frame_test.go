@@ -132,6 +132,46 @@ func TestFrame_GetValue(t *testing.T) {
 			},
 			expValueWithUnit: "123imp/kWh",
 		},
+		{
+			buf: []byte{0x05, 0x23, 0x01, 0x00},
+			dic: DICRemainingAmount,
+			exp: &Value{
+				Name:  DICRemainingAmount.Name(),
+				Unit:  DICRemainingAmount.Unit(),
+				Value: MustNewFromString("123.05"),
+			},
+			expValueWithUnit: "123.05元",
+		},
+		{
+			buf: []byte{0x56, 0x34, 0x92},
+			dic: DICTotalActivePower,
+			exp: &Value{
+				Name:  DICTotalActivePower.Name(),
+				Unit:  DICTotalActivePower.Unit(),
+				Value: MustNewFromString("-12.3456"),
+			},
+			expValueWithUnit: "-12.3456kW",
+		},
+		{
+			buf: []byte{0x25, 0x80},
+			dic: DICTemperature,
+			exp: &Value{
+				Name:  DICTemperature.Name(),
+				Unit:  DICTemperature.Unit(),
+				Value: MustNewFromString("-2.5"),
+			},
+			expValueWithUnit: "-2.5℃",
+		},
+		{
+			buf: []byte{0x05, 0x12},
+			dic: DICPhaseAAngle,
+			exp: &Value{
+				Name:  DICPhaseAAngle.Name(),
+				Unit:  DICPhaseAAngle.Unit(),
+				Value: MustNewFromString("120.5"),
+			},
+			expValueWithUnit: "120.5°",
+		},
 	}
 
 	for _, tt := range tests {
@@ -170,7 +210,7 @@ func TestFrame_NewReadFrame(t *testing.T) {
 			addr:     "1234567",
 			dic:      DICPhaseAVoltage,
 			protocol: PV1997,
-			expBytes: []byte{0x68, 0x67, 0x45, 0x23, 0x1, 0x0, 0x0, 0x68, 0x11, 0x2, 0x44, 0xe9, 0xe0, 0x16},
+			expBytes: []byte{0x68, 0x67, 0x45, 0x23, 0x1, 0x0, 0x0, 0x68, 0x01, 0x2, 0x44, 0xe9, 0xd0, 0x16},
 			expErr:   nil,
 		},
 	}
@@ -183,3 +223,110 @@ func TestFrame_NewReadFrame(t *testing.T) {
 		})
 	}
 }
+
+func TestFrame_OldDIC(t *testing.T) {
+	isBlock, dics := DICPositiveActiveEnergy.CheckBlock(PV1997)
+	assert.True(t, isBlock)
+	assert.Equal(t, []DIC{DICPositiveTotalActiveEnergy, DICPositiveActiveEnergyRate1, DICPositiveActiveEnergyRate2,
+		DICPositiveActiveEnergyRate3, DICPositiveActiveEnergyRate4}, dics)
+
+	isBlock, dics = DICVoltage.CheckBlock(PV1997)
+	assert.True(t, isBlock)
+	assert.Equal(t, []DIC{DICPhaseAVoltage, DICPhaseBVoltage, DICPhaseCVoltage}, dics)
+
+	// 1997的正向无功和反向无功不是2007的组合无功
+	assert.False(t, DICTotalReactiveEnergy1.Supported(PV1997))
+	assert.False(t, DICReactiveMaxDemand2.Supported(PV1997))
+	assert.Equal(t, []byte{0x10, 0x91}, DICPositiveTotalReactiveEnergy.Code(PV1997))
+	assert.False(t, DICPositiveTotalReactiveEnergy.Supported(PV2007))
+	isBlock, dics = DICNegativeReactiveEnergy.CheckBlock(PV1997)
+	assert.True(t, isBlock)
+	assert.Equal(t, []DIC{DICNegativeTotalReactiveEnergy, DICNegativeReactiveEnergyRate1, DICNegativeReactiveEnergyRate2,
+		DICNegativeReactiveEnergyRate3, DICNegativeReactiveEnergyRate4}, dics)
+
+	// 1997专用数据标识在2007中不是数据块
+	isBlock, dics = DICPhaseBreakCount.CheckBlock(PV2007)
+	assert.False(t, isBlock)
+	assert.Equal(t, []DIC{DICPhaseBreakCount}, dics)
+
+	_, err := NewReadFrame("1234567890", DICPhaseBreakCount, PV2007)
+	assert.Error(t, err)
+	_, err = NewReadFrame("1234567890", DICPhaseAAngle, PV1997)
+	assert.Error(t, err)
+
+	// 2007需量数据带发生时间, 只解析需量值
+	buf := []byte{0x56, 0x34, 0x12, 0x30, 0x10, 0x15, 0x06, 0x24}
+	assert.Equal(t, "12.3456", DICPositiveActiveMaxDemand.Decode(buf, PV2007).String())
+	assert.Equal(t, "12.3456", DICPositiveActiveMaxDemand.Decode(buf[:3], PV1997).String())
+
+	// 1997没有符号位
+	assert.Equal(t, "92.3456", DICTotalActivePower.Decode([]byte{0x56, 0x34, 0x92}, PV1997).String())
+}
+
+func TestFrame_NewWriteFrame(t *testing.T) {
+	password := &Password{Level: 0x02, Password: "123456", Operator: "12345678"}
+
+	f, err := NewWriteFrame("1234567890", DICTimeZoneCount, PV2007, password, []byte{0x02})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x68, 0x90, 0x78, 0x56, 0x34, 0x12, 0x0, 0x68, 0x14, 0xd, 0x34, 0x35, 0x33, 0x37, 0x35,
+		0x89, 0x67, 0x45, 0xab, 0x89, 0x67, 0x45, 0x35, 0xe7, 0x16}, f.Bytes())
+
+	_, err = NewWriteFrame("1234567890", DICTimeZoneCount, PV2007, &Password{Password: "12a"}, []byte{0x02})
+	assert.Error(t, err)
+
+	// 2007的时区表超过50字节, 1997写数据不超过50字节
+	_, err = NewWriteFrame("1234567890", DICTimeZoneCount, PV2007, password, make([]byte, MaxWriteLen2007-12))
+	assert.NoError(t, err)
+	_, err = NewWriteFrame("1234567890", DICTimeZoneCount, PV2007, password, make([]byte, MaxWriteLen2007-11))
+	assert.Error(t, err)
+	_, err = NewWriteFrame("1234567890", DICTimeZoneCount, PV1997, password, make([]byte, 10))
+	assert.NoError(t, err)
+	_, err = NewWriteFrame("1234567890", DICTimeZoneCount, PV1997, password, make([]byte, MaxWriteLen1997))
+	assert.Error(t, err)
+}
+
+func TestFrame_FrameError(t *testing.T) {
+	f := &Frame{Start: FrameStartByte, AddrEnd: FrameStartByte, C: 0xD4, L: 1, Data: []byte{byte(ErrorCodeYEAR) + DATA_MASK}, End: FrameEndByte}
+	f.CalcCS()
+
+	err := f.CheckEndError()
+	assert.EqualError(t, err, "frame has error: 年时区数超")
+	assert.True(t, errors.Is(err, ErrorCodeYEAR))
+	assert.False(t, errors.Is(err, ErrorCodeDAY))
+
+	err = &FrameError{Code: ErrorCodeDAY | ErrorCodeRATE}
+	assert.EqualError(t, err, "frame has error: 费率数超,日时段数超")
+	assert.True(t, errors.Is(err, ErrorCodeDAY))
+}
+
+func TestFrame_MatchResponse(t *testing.T) {
+	req, err := NewReadFrame("12345678", DICPhaseAVoltage, PV2007)
+	assert.NoError(t, err)
+	echo := DICPhaseAVoltage.Code(PV2007)
+
+	resp := &Frame{C: 0x91, Address: req.Address, Data: append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23)}
+	assert.NoError(t, req.MatchResponse(resp, echo))
+
+	// 请求帧本身, 如半双工的回显
+	assert.ErrorIs(t, req.MatchResponse(req, echo), ErrMismatch)
+
+	// 功能码不一致
+	assert.ErrorIs(t, req.MatchResponse(&Frame{C: 0x94, Address: req.Address}, echo), ErrMismatch)
+
+	// 其他电表的应答
+	other := &Frame{C: 0x91, Data: resp.Data}
+	_ = other.SetAddress("87654321", false)
+	assert.ErrorIs(t, req.MatchResponse(other, echo), ErrMismatch)
+
+	// 上一个请求的迟到应答
+	stale := &Frame{C: 0x91, Address: req.Address, Data: append(DICPhaseBVoltage.Code(PV2007), 0x00, 0x23)}
+	assert.ErrorIs(t, req.MatchResponse(stale, echo), ErrMismatch)
+
+	// 异常应答没有数据标识
+	assert.NoError(t, req.MatchResponse(&Frame{C: 0xD1, Address: req.Address, Data: []byte{byte(ErrorCodeDATA)}}, echo))
+
+	// 缩位地址匹配任意地址
+	readAddr := &Frame{C: NewCode(CRDA)}
+	assert.NoError(t, readAddr.SetAddress("", true))
+	assert.NoError(t, readAddr.MatchResponse(&Frame{C: 0x93, Address: req.Address}, nil))
+}
@@ -0,0 +1,79 @@
+package dlt645
+
+import (
+	"fmt"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	MinHarmonicOrder = 2  // 谐波含量的最低次数
+	MaxHarmonicOrder = 21 // 谐波含量的最高次数
+)
+
+// HarmonicSpectrum 谐波含量数据块, 按谐波次数排序. 按DL/T 645-2007的数据标识, 总谐波含量的序号是01,
+// 2-21次谐波含量的序号是02-15, 序号FF是数据块, 如A相电压的总谐波含量是020A0101, 数据块是020A01FF
+type HarmonicSpectrum struct {
+	Name      string
+	Unit      string
+	THD       decimal.Decimal   // 总谐波含量
+	Harmonics []decimal.Decimal // 2-21次谐波含量, Harmonics[0]是2次谐波
+}
+
+// Harmonic return the content of the n order harmonic, n is from MinHarmonicOrder to MaxHarmonicOrder
+func (s *HarmonicSpectrum) Harmonic(n int) decimal.Decimal {
+	if n < MinHarmonicOrder || n-MinHarmonicOrder >= len(s.Harmonics) {
+		return decimal.Zero
+	}
+	return s.Harmonics[n-MinHarmonicOrder]
+}
+
+// IsHarmonicBlock return true if the dic is the voltage or current harmonic block of one phase, like DICPhaseAVoltageHarmonic
+func (dic DIC) IsHarmonicBlock() bool {
+	v := dic.Val()
+	return (v>>16 == 0x020A || v>>16 == 0x020B) && v&0xFF == 0xFF && dic.IsValid()
+}
+
+// newHarmonicSpectrum build the spectrum from the values of the harmonic block
+func newHarmonicSpectrum(block DIC, values []*Value) (*HarmonicSpectrum, error) {
+	_, dics := block.CheckBlock(PV2007)
+	if len(values) != len(dics) {
+		return nil, fmt.Errorf("%s values length %d not equals %d", block, len(values), len(dics))
+	}
+
+	s := &HarmonicSpectrum{Name: block.Name(), Unit: block.Unit()}
+	for i, v := range values {
+		if v.Err != nil {
+			return nil, v.Err
+		}
+
+		if dics[i].Val()&0xFF == 0x01 {
+			s.THD = v.Value
+		} else {
+			s.Harmonics = append(s.Harmonics, v.Value)
+		}
+	}
+
+	return s, nil
+}
+
+func (c *client) ReadHarmonics(addr string, block DIC) (*HarmonicSpectrum, error) {
+	if c.protocol(addr) != PV2007 {
+		return nil, fmt.Errorf("1997 unsupport %s", block)
+	}
+
+	if !block.IsHarmonicBlock() {
+		return nil, fmt.Errorf("%s is not a harmonic block", block)
+	}
+
+	data, err := c.readData(addr, block)
+	if err != nil {
+		return nil, err
+	}
+
+	_, dics := block.CheckBlock(PV2007)
+	if len(data) < len(dics)*block.Size(PV2007) {
+		return nil, fmt.Errorf("%s data length %d is less than %d", block, len(data), len(dics)*block.Size(PV2007))
+	}
+
+	return newHarmonicSpectrum(block, c.getValue(data, block, PV2007))
+}
@@ -0,0 +1,38 @@
+package dlt645
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHarmonic_CheckBlock(t *testing.T) {
+	isBlock, dics := DICPhaseBCurrentHarmonic.CheckBlock(PV2007)
+	assert.True(t, isBlock)
+	assert.Len(t, dics, MaxHarmonicOrder)
+	assert.Equal(t, DICPhaseBCurrentTHD, dics[0])
+	assert.Equal(t, DICPhaseBCurrentHarmonic2, dics[1])
+	assert.Equal(t, DICPhaseBCurrentHarmonic21, dics[len(dics)-1])
+
+	assert.True(t, DICPhaseAVoltageHarmonic.IsHarmonicBlock())
+	assert.False(t, DICPhaseAVoltageHarmonic3.IsHarmonicBlock())
+	assert.False(t, DICVoltage.IsHarmonicBlock())
+}
+
+func TestHarmonic_newHarmonicSpectrum(t *testing.T) {
+	c := &client{}
+
+	// 总谐波含量 12.34%, n次谐波含量 n.00%
+	data := []byte{0x34, 0x12}
+	for n := MinHarmonicOrder; n <= MaxHarmonicOrder; n++ {
+		data = append(data, uintToBcd(uint64(n*100), 2)...)
+	}
+
+	s, err := newHarmonicSpectrum(DICPhaseCVoltageHarmonic, c.getValue(data, DICPhaseCVoltageHarmonic, PV2007))
+	assert.NoError(t, err)
+	assert.Equal(t, "12.34", s.THD.String())
+	assert.Len(t, s.Harmonics, MaxHarmonicOrder-MinHarmonicOrder+1)
+	assert.Equal(t, "3", s.Harmonic(3).String())
+	assert.Equal(t, "21", s.Harmonic(21).String())
+	assert.True(t, s.Harmonic(22).IsZero())
+}
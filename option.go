@@ -0,0 +1,78 @@
+package dlt645
+
+import (
+	"time"
+
+	"github.com/expgo/log"
+)
+
+// ClientOption the option of NewClient
+type ClientOption func(c *client)
+
+// WithProtocol set the default protocol of the client, default is PV2007
+func WithProtocol(protocol P) ClientOption {
+	return func(c *client) {
+		c.Protocol = protocol
+	}
+}
+
+// WithAddressProtocol override the protocol of the meter address, used when the bus has meters of 1997 and 2007
+func WithAddressProtocol(addr string, protocol P) ClientOption {
+	return func(c *client) {
+		c.protocols[addr] = protocol
+	}
+}
+
+// WithTimeout set the timeout of one request, from sending the request to receiving the whole response,
+// default is the FrameTimeout of the transporter timing, or the ReadTimeout of the transporter without the timing
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *client) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// WithPreambles set the count of the 0xFE preamble bytes before the request frame, default is PRE_BYTE_LEN
+func WithPreambles(n int) ClientOption {
+	return func(c *client) {
+		if n < 0 {
+			n = 0
+		}
+		c.preambles = n
+	}
+}
+
+// WithRetries set the retry times when the request failed, the error response of the meter is not retried.
+// it is the same as the MaxAttempts of the RetryPolicy is n+1
+func WithRetries(n int) ClientOption {
+	return func(c *client) {
+		if n < 0 {
+			n = 0
+		}
+		c.retry.MaxAttempts = n + 1
+	}
+}
+
+// WithRetryPolicy set the retry policy of the request, such as the backoff and the statistics of the attempts
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *client) {
+		c.retry = policy
+	}
+}
+
+// WithLogger set the logger of the client
+func WithLogger(logger log.Logger) ClientOption {
+	return func(c *client) {
+		c.L = logger
+	}
+}
+
+// WithLocation set the time location of the meter clock, default is time.Local
+func WithLocation(loc *time.Location) ClientOption {
+	return func(c *client) {
+		if loc != nil {
+			c.location = loc
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package dlt645
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption_NewClient(t *testing.T) {
+	var written []byte
+	tr := newTestTransporter(t, func(req []byte) [][]byte {
+		written = append(written, req...)
+		return nil
+	})
+	c := NewClient(tr, WithProtocol(PV1997), WithAddressProtocol("2", PV2007), WithPreambles(0),
+		WithTimeout(100*time.Millisecond), WithRetries(1)).(*client)
+
+	assert.Equal(t, PV1997, c.protocol("1"))
+	assert.Equal(t, PV2007, c.protocol("2"))
+
+	// 1997 读数据控制码为0x01, 应答为0x81, 应答前导字节数不固定
+	tr.Inject(testFrame("1", 0x81, append(DICPhaseAVoltage.Code(PV1997), 0x30, 0x02), 2))
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+	assert.Equal(t, Code(0x01), Code(written[8]))
+	assert.True(t, tr.nextReadTimeout > 0 && tr.nextReadTimeout <= 100*time.Millisecond)
+
+	// 第一次没有应答, 重试一次
+	written = nil
+	v = c.Read("2", DICPhaseAVoltage)
+	assert.Error(t, v[0].Err)
+	assert.Equal(t, 2*16, len(written))
+
+	written = nil
+	tr.Inject(testFrame("2", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x01, 0x23), 4))
+	v = c.Read("2", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230.1", v[0].Value.String())
+	assert.Equal(t, FrameStartByte, int(written[0]))
+
+	// 异常应答不重试
+	written = nil
+	tr.Inject(testFrame("2", 0xD1, []byte{byte(ErrorCodeDATA)}, 0))
+	v = c.Read("2", DICPhaseAVoltage)
+	assert.ErrorIs(t, v[0].Err, ErrorCodeDATA)
+	assert.Equal(t, 16, len(written))
+}
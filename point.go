@@ -0,0 +1,190 @@
+package dlt645
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/*
+Point the semantic point of the meter, which is mapped to the candidate dics of each protocol,
+the value of the point has the same unit and scale for 1997 and 2007
+
+	@EnumConfig(noCase, Values)
+	@Enum(unit string, scale int) {
+		// 电能量
+		TotalActiveEnergy           ("kWh", 2)   // 组合有功总电能
+		PositiveTotalActiveEnergy   ("kWh", 2)   // 正向有功总电能
+		NegativeTotalActiveEnergy   ("kWh", 2)   // 反向有功总电能
+		TotalReactiveEnergy1        ("kvarh", 2) // 组合无功1总电能
+		TotalReactiveEnergy2        ("kvarh", 2) // 组合无功2总电能
+		FirstQuadrantReactiveEnergy ("kvarh", 2) // 第一象限无功电能
+		SecondQuadrantReactiveEnergy("kvarh", 2) // 第二象限无功电能
+		ThirdQuadrantReactiveEnergy ("kvarh", 2) // 第三象限无功电能
+		FourthQuadrantReactiveEnergy("kvarh", 2) // 第四象限无功电能
+
+		// 需量
+		PositiveActiveMaxDemand     ("kW", 4)    // 正向有功总最大需量
+		NegativeActiveMaxDemand     ("kW", 4)    // 反向有功总最大需量
+
+		// 变量
+		PhaseAVoltage               ("V", 1)     // A相电压
+		PhaseBVoltage               ("V", 1)     // B相电压
+		PhaseCVoltage               ("V", 1)     // C相电压
+		PhaseACurrent               ("A", 3)     // A相电流
+		PhaseBCurrent               ("A", 3)     // B相电流
+		PhaseCCurrent               ("A", 3)     // C相电流
+		TotalActivePower            ("kW", 4)    // 总有功功率
+		PhaseAActivePower           ("kW", 4)    // A相有功功率
+		PhaseBActivePower           ("kW", 4)    // B相有功功率
+		PhaseCActivePower           ("kW", 4)    // C相有功功率
+		TotalReactivePower          ("kvar", 4)  // 总无功功率
+		PhaseAReactivePower         ("kvar", 4)  // A相无功功率
+		PhaseBReactivePower         ("kvar", 4)  // B相无功功率
+		PhaseCReactivePower         ("kvar", 4)  // C相无功功率
+		TotalPowerFactor            ("", 3)      // 总功率因素
+		PhaseAPowerFactor           ("", 3)      // A相功率因素
+		PhaseBPowerFactor           ("", 3)      // B相功率因素
+		PhaseCPowerFactor           ("", 3)      // C相功率因素
+		Frequency                   ("Hz", 2)    // 电网频率
+		Temperature                 ("℃", 1)     // 表内温度
+
+		// 参变量
+		ActiveConstant              ("imp/kWh", 0)   // 电表有功常数
+		ReactiveConstant            ("imp/kvarh", 0) // 电表无功常数
+		TotalProgramCount           ("次", 0)        // 编程总次数
+		TotalDemandResetCount       ("次", 0)        // 需量清零总次数
+		BatteryRunTime              ("分", 0)        // 电池工作时间
+	}
+*/
+type Point int
+
+// pointDICs the candidate dics of the point in order of preference. the first dic is the item of the point, the
+// next dic is read when the meter answer ErrorCodeDATA for the previous one, the value of the item is picked from the
+// block dic, e.g. the old meter which only answer the block 901F. the point without the block has no fallback
+var pointDICs = map[Point][]DIC{
+	PointTotalActiveEnergy:            {DICTotalActiveEnergy},
+	PointPositiveTotalActiveEnergy:    {DICPositiveTotalActiveEnergy, DICPositiveActiveEnergy},
+	PointNegativeTotalActiveEnergy:    {DICNegativeTotalActiveEnergy, DICNegativeActiveEnergy},
+	PointTotalReactiveEnergy1:         {DICTotalReactiveEnergy1},
+	PointTotalReactiveEnergy2:         {DICTotalReactiveEnergy2},
+	PointFirstQuadrantReactiveEnergy:  {DICFirstQuadrantReactiveEnergy},
+	PointSecondQuadrantReactiveEnergy: {DICSecondQuadrantReactiveEnergy},
+	PointThirdQuadrantReactiveEnergy:  {DICThirdQuadrantReactiveEnergy},
+	PointFourthQuadrantReactiveEnergy: {DICFourthQuadrantReactiveEnergy},
+	PointPositiveActiveMaxDemand:      {DICPositiveActiveMaxDemand},
+	PointNegativeActiveMaxDemand:      {DICNegativeActiveMaxDemand},
+	PointPhaseAVoltage:                {DICPhaseAVoltage, DICVoltage},
+	PointPhaseBVoltage:                {DICPhaseBVoltage, DICVoltage},
+	PointPhaseCVoltage:                {DICPhaseCVoltage, DICVoltage},
+	PointPhaseACurrent:                {DICPhaseACurrent, DICCurrent},
+	PointPhaseBCurrent:                {DICPhaseBCurrent, DICCurrent},
+	PointPhaseCCurrent:                {DICPhaseCCurrent, DICCurrent},
+	PointTotalActivePower:             {DICTotalActivePower, DICActivePower},
+	PointPhaseAActivePower:            {DICPhaseAActivePower, DICActivePower},
+	PointPhaseBActivePower:            {DICPhaseBActivePower, DICActivePower},
+	PointPhaseCActivePower:            {DICPhaseCActivePower, DICActivePower},
+	PointTotalReactivePower:           {DICTotalReactivePower, DICReactivePower},
+	PointPhaseAReactivePower:          {DICPhaseAReactivePower, DICReactivePower},
+	PointPhaseBReactivePower:          {DICPhaseBReactivePower, DICReactivePower},
+	PointPhaseCReactivePower:          {DICPhaseCReactivePower, DICReactivePower},
+	PointTotalPowerFactor:             {DICTotalPowerFactor, DICPowerFactor},
+	PointPhaseAPowerFactor:            {DICPhaseAPowerFactor, DICPowerFactor},
+	PointPhaseBPowerFactor:            {DICPhaseBPowerFactor, DICPowerFactor},
+	PointPhaseCPowerFactor:            {DICPhaseCPowerFactor, DICPowerFactor},
+	PointFrequency:                    {DICFrequency},
+	PointTemperature:                  {DICTemperature},
+	PointActiveConstant:               {DICActiveConstant},
+	PointReactiveConstant:             {DICReactiveConstant},
+	PointTotalProgramCount:            {DICTotalProgramCount},
+	PointTotalDemandResetCount:        {DICTotalDemandResetCount},
+	PointBatteryRunTime:               {DICBatteryRunTime},
+}
+
+// candidates return the candidate dics of the point for the protocol in order of preference
+func (x Point) candidates(protocol P) (ret []DIC) {
+	for _, dic := range pointDICs[x] {
+		if dic.Supported(protocol) && !dic.IsRaw(protocol) {
+			ret = append(ret, dic)
+		}
+	}
+	return ret
+}
+
+// DIC return the best dic of the point for the protocol, false if the protocol has no dic of the point
+func (x Point) DIC(protocol P) (DIC, bool) {
+	if dics := x.candidates(protocol); len(dics) > 0 {
+		return dics[0], true
+	}
+	return 0, false
+}
+
+// Supported return true if the protocol has the dic of the point
+func (x Point) Supported(protocol P) bool {
+	_, ok := x.DIC(protocol)
+	return ok
+}
+
+// pick return the value of the point from the values of the dic, which is the value of the item for the block dic
+func (x Point) pick(dic DIC, protocol P, rets []*Value) (*Value, error) {
+	item := pointDICs[x][0]
+	if dic == item {
+		if len(rets) != 1 {
+			return nil, fmt.Errorf("%s is not a single value dic", dic)
+		}
+		return rets[0], nil
+	}
+
+	_, dics := dic.CheckBlock(protocol)
+	for i, d := range dics {
+		if d == item && i < len(rets) {
+			return rets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not in the block %s", item, dic)
+}
+
+// pointValue convert the value of the dic to the value of the point, which is rounded to the scale of the point
+func (x Point) pointValue(v *Value) *Value {
+	v.Name = x.Name()
+	v.Unit = x.Unit()
+	if v.Err == nil && v.Data == nil {
+		v.Value = v.Value.Round(int32(x.Scale()))
+	}
+	return v
+}
+
+func (c *client) ReadPoints(addr string, points ...Point) []*Value {
+	return c.ReadPointsContext(context.Background(), addr, points...)
+}
+
+func (c *client) ReadPointsContext(ctx context.Context, addr string, points ...Point) (values []*Value) {
+	for _, point := range points {
+		values = append(values, c.readPoint(ctx, addr, point))
+	}
+
+	return values
+}
+
+// readPoint read the candidate dics of the point in order until the meter answer the data, the error of the last
+// candidate is returned when all the candidates are answered with ErrorCodeDATA
+func (c *client) readPoint(ctx context.Context, addr string, point Point) *Value {
+	protocol := c.protocol(addr)
+	dics := point.candidates(protocol)
+	if len(dics) == 0 {
+		return &Value{Name: point.Name(), Unit: point.Unit(), Err: fmt.Errorf("%s unsupport point %s", protocol, point)}
+	}
+
+	var v *Value
+	for _, dic := range dics {
+		var err error
+		if v, err = point.pick(dic, protocol, c.ReadContext(ctx, addr, dic)); err != nil {
+			return &Value{Name: point.Name(), Unit: point.Unit(), Err: err}
+		}
+		if !errors.Is(v.Err, ErrorCodeDATA) {
+			break
+		}
+	}
+
+	return point.pointValue(v)
+}
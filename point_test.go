@@ -0,0 +1,90 @@
+package dlt645
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoint_DIC(t *testing.T) {
+	for _, point := range PointValues() {
+		assert.NotEmpty(t, pointDICs[point], point.String())
+		assert.True(t, point.Supported(PV2007), point.String())
+	}
+
+	dic, ok := PointPhaseAVoltage.DIC(PV1997)
+	assert.True(t, ok)
+	assert.Equal(t, DICPhaseAVoltage, dic)
+
+	_, ok = PointTotalActiveEnergy.DIC(PV1997)
+	assert.False(t, ok)
+	assert.Nil(t, DICTotalActiveEnergy.Code(PV1997))
+	assert.Equal(t, 0, DICTotalActiveEnergy.Size(PV1997))
+
+	// 1997没有组合无功
+	assert.False(t, PointTotalReactiveEnergy1.Supported(PV1997))
+	assert.False(t, PointTotalReactiveEnergy2.Supported(PV1997))
+
+	// 候选的数据块包含测量点的数据项
+	for _, point := range PointValues() {
+		item := pointDICs[point][0]
+		for _, protocol := range []P{PV1997, PV2007} {
+			dics := point.candidates(protocol)
+			if len(dics) < 2 {
+				continue
+			}
+			for _, dic := range dics[1:] {
+				_, dics := dic.CheckBlock(protocol)
+				assert.Contains(t, dics, item, "%s %s %s", point, protocol, dic)
+			}
+		}
+	}
+}
+
+func TestClient_ReadPoints(t *testing.T) {
+	noData := testFrame(testMeterAddr, 0xD1, []byte{byte(ErrorCodeDATA)}, 0)
+	voltage, err := NewReadFrame(testMeterAddr, DICPhaseBVoltage, PV2007)
+	assert.NoError(t, err)
+	reactive, err := NewReadFrame(testMeterAddr, DICTotalReactiveEnergy1, PV2007)
+	assert.NoError(t, err)
+	transport := NewMockTransporter().
+		Expect(voltage.Bytes(), noData).
+		ExpectRead(testMeterAddr, DICVoltage, PV2007, []byte{0x01, 0x23, 0x02, 0x23, 0x03, 0x23}).
+		ExpectRead(testMeterAddr, DICFrequency, PV2007, []byte{0x00, 0x50}).
+		Expect(reactive.Bytes(), noData)
+	assert.NoError(t, transport.Open())
+	defer transport.Close()
+
+	// 电表不支持B相电压的数据项时从电压数据块中读取
+	c := NewClient(transport, WithAddressProtocol(testMeterAddr, PV2007))
+	v := c.ReadPoints(testMeterAddr, PointPhaseBVoltage, PointFrequency, PointTotalReactiveEnergy1)
+	assert.NoError(t, transport.Verify())
+	assert.Len(t, v, 3)
+
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, PointPhaseBVoltage.Name(), v[0].Name)
+	assert.Equal(t, "230.2", v[0].Value.String())
+	assert.NoError(t, v[1].Err)
+	assert.Equal(t, "50", v[1].Value.String())
+
+	// 没有候选的数据块时返回电表的异常应答
+	assert.ErrorIs(t, v[2].Err, ErrorCodeDATA)
+	assert.Equal(t, PointTotalReactiveEnergy1.Name(), v[2].Name)
+}
+
+func TestPoint_pointValue(t *testing.T) {
+	// 1997电压格式为XXX, 2007为XXX.X, 统一为1位小数
+	old := PointPhaseAVoltage.pointValue((&client{}).getValue([]byte{0x30, 0x02}, DICPhaseAVoltage, PV1997)[0])
+	assert.Equal(t, "230.0V", old.Value.StringFixed(int32(PointPhaseAVoltage.Scale()))+old.Unit)
+	assert.Equal(t, int32(-1), old.Value.Exponent())
+	assert.Equal(t, PointPhaseAVoltage.Name(), old.Name)
+
+	v := PointPhaseAVoltage.pointValue((&client{}).getValue([]byte{0x01, 0x23}, DICPhaseAVoltage, PV2007)[0])
+	assert.Equal(t, "230.1", v.Value.String())
+	assert.Equal(t, int32(-1), v.Value.Exponent())
+
+	// 1997无功功率格式为XX.XX, 2007为XX.XXXX
+	old = PointTotalReactivePower.pointValue((&client{}).getValue([]byte{0x34, 0x12}, DICTotalReactivePower, PV1997)[0])
+	assert.Equal(t, "12.3400", old.Value.StringFixed(4))
+	assert.Equal(t, int32(-4), old.Value.Exponent())
+}
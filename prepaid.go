@@ -0,0 +1,143 @@
+package dlt645
+
+import (
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+const MaxPurchaseRecords = 10 // 购电记录最多保存上10次
+
+// PrepaidStatus 费控电能表的当前状态, 金额单位为元
+type PrepaidStatus struct {
+	RemainingAmount      decimal.Decimal // 当前剩余金额
+	OverdraftAmount      decimal.Decimal // 当前透支金额
+	PurchaseCount        int64           // 累计购电次数
+	TotalPurchaseAmount  decimal.Decimal // 累计购电金额
+	AlarmAmount1Limit    decimal.Decimal // 报警金额1限值
+	AlarmAmount2Limit    decimal.Decimal // 报警金额2限值
+	OverdraftAmountLimit decimal.Decimal // 透支金额限值
+}
+
+// PurchaseRecord 购电记录, 金额单位为元
+type PurchaseRecord struct {
+	Seq                 int             // 上N次购电
+	Time                time.Time       // 购电日期
+	Count               int64           // 购电后总购电次数
+	Amount              decimal.Decimal // 购电金额
+	BalanceBefore       decimal.Decimal // 购电前剩余金额
+	BalanceAfter        decimal.Decimal // 购电后剩余金额
+	TotalPurchaseAmount decimal.Decimal // 购电后累计购电金额
+}
+
+// readDecimal read the single value dic, and return the error of the value
+func (c *client) readDecimal(addr string, dic DIC) (decimal.Decimal, error) {
+	values := c.Read(addr, dic)
+	if len(values) != 1 {
+		return decimal.Zero, fmt.Errorf("%s is not a single value dic", dic)
+	}
+
+	return values[0].Value, values[0].Err
+}
+
+// readLastN read the last n record of the last 1 dic, and decode the data with the last 1 dic
+func (c *client) readLastN(addr string, last1 DIC, n int) ([]byte, error) {
+	data, err := c.readData(addr, DIC(last1.Val()+uint32(n-1)))
+	if err != nil {
+		return nil, err
+	}
+
+	if size := last1.Size(c.protocol(addr)); len(data) < size {
+		return nil, fmt.Errorf("%s data length %d is less than %d", last1, len(data), size)
+	}
+
+	return data, nil
+}
+
+func (c *client) ReadPrepaidStatus(addr string) (s *PrepaidStatus, err error) {
+	if c.protocol(addr) != PV2007 {
+		return nil, errors.New("1997 unsupport prepaid data")
+	}
+
+	s = &PrepaidStatus{}
+
+	fields := []struct {
+		dic   DIC
+		value *decimal.Decimal
+	}{
+		{DICRemainingAmount, &s.RemainingAmount},
+		{DICOverdraftAmount, &s.OverdraftAmount},
+		{DICLastTotalPurchaseAmount, &s.TotalPurchaseAmount},
+		{DICAlarmAmount1Limit, &s.AlarmAmount1Limit},
+		{DICAlarmAmount2Limit, &s.AlarmAmount2Limit},
+		{DICOverdraftAmountLimit, &s.OverdraftAmountLimit},
+	}
+
+	for _, f := range fields {
+		if *f.value, err = c.readDecimal(addr, f.dic); err != nil {
+			return nil, fmt.Errorf("read %s failed: %w", f.dic, err)
+		}
+	}
+
+	count, err := c.readDecimal(addr, DICLastPurchaseCount)
+	if err != nil {
+		return nil, fmt.Errorf("read %s failed: %w", DICLastPurchaseCount, err)
+	}
+	s.PurchaseCount = count.IntPart()
+
+	return s, nil
+}
+
+func (c *client) ReadPurchaseRecords(addr string, lastN int) (records []*PurchaseRecord, err error) {
+	if c.protocol(addr) != PV2007 {
+		return nil, errors.New("1997 unsupport prepaid data")
+	}
+
+	if lastN < 1 || lastN > MaxPurchaseRecords {
+		return nil, fmt.Errorf("lastN must be between 1 and %d", MaxPurchaseRecords)
+	}
+
+	for n := 1; n <= lastN; n++ {
+		r := &PurchaseRecord{Seq: n}
+
+		data, err1 := c.readLastN(addr, DICLastPurchaseCount, n)
+		if err1 != nil {
+			return records, err1
+		}
+		r.Count = DICLastPurchaseCount.Decode(data, PV2007).IntPart()
+
+		// 没有发生过的购电记录次数为0
+		if r.Count == 0 {
+			break
+		}
+
+		if data, err1 = c.readLastN(addr, DICLastPurchaseTime, n); err1 != nil {
+			return records, err1
+		}
+		if r.Time, err1 = bcdToTime(data[:DICLastPurchaseTime.Size(PV2007)], c.location); err1 != nil {
+			return records, err1
+		}
+
+		amounts := []struct {
+			dic   DIC
+			value *decimal.Decimal
+		}{
+			{DICLastPurchaseAmount, &r.Amount},
+			{DICLastPurchaseBalanceBefore, &r.BalanceBefore},
+			{DICLastPurchaseBalanceAfter, &r.BalanceAfter},
+			{DICLastTotalPurchaseAmount, &r.TotalPurchaseAmount},
+		}
+
+		for _, a := range amounts {
+			if data, err1 = c.readLastN(addr, a.dic, n); err1 != nil {
+				return records, err1
+			}
+			*a.value = a.dic.Decode(data, PV2007)
+		}
+
+		records = append(records, r)
+	}
+
+	return records, nil
+}
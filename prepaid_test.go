@@ -0,0 +1,53 @@
+package dlt645
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepaid_DIC(t *testing.T) {
+	tests := []struct {
+		dic  DIC
+		code uint32
+	}{
+		{DICRemainingAmount, 0x00900200},
+		{DICOverdraftAmount, 0x00900201},
+		{DICLastPurchaseCount, 0x03330201},
+		{DICLastTotalPurchaseAmount, 0x03330601},
+		{DICAlarmAmount1Limit, 0x04001001},
+		{DICAlarmAmount2Limit, 0x04001002},
+		{DICOverdraftAmountLimit, 0x04001003},
+		{DICHoardingAmountLimit, 0x04001004},
+		{DICCloseAllowedAmountLimit, 0x04001005},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dic.String(), func(t *testing.T) {
+			assert.Equal(t, tt.code, tt.dic.Val())
+		})
+	}
+}
+
+func TestClient_ReadPrepaidStatus(t *testing.T) {
+	transport := NewMockTransporter().
+		ExpectRead(testMeterAddr, DICRemainingAmount, PV2007, []byte{0x00, 0x50, 0x01, 0x00}).
+		ExpectRead(testMeterAddr, DICOverdraftAmount, PV2007, []byte{0x00, 0x00, 0x00, 0x00}).
+		ExpectRead(testMeterAddr, DICLastTotalPurchaseAmount, PV2007, []byte{0x00, 0x00, 0x10, 0x00}).
+		ExpectRead(testMeterAddr, DICAlarmAmount1Limit, PV2007, []byte{0x00, 0x20, 0x00, 0x00}).
+		ExpectRead(testMeterAddr, DICAlarmAmount2Limit, PV2007, []byte{0x00, 0x10, 0x00, 0x00}).
+		ExpectRead(testMeterAddr, DICOverdraftAmountLimit, PV2007, []byte{0x00, 0x50, 0x00, 0x00}).
+		ExpectRead(testMeterAddr, DICLastPurchaseCount, PV2007, []byte{0x12, 0x00})
+	assert.NoError(t, transport.Open())
+	defer transport.Close()
+
+	c := NewClient(transport, WithAddressProtocol(testMeterAddr, PV2007))
+	s, err := c.ReadPrepaidStatus(testMeterAddr)
+	assert.NoError(t, err)
+	assert.NoError(t, transport.Verify())
+
+	assert.Equal(t, "150", s.RemainingAmount.String())
+	assert.Equal(t, "1000", s.TotalPurchaseAmount.String())
+	assert.Equal(t, "50", s.OverdraftAmountLimit.String())
+	assert.Equal(t, int64(12), s.PurchaseCount)
+}
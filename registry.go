@@ -0,0 +1,352 @@
+package dlt645
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DICDefinition the definition of the runtime registered dic, like the vendor specific dic 0x04800001
+type DICDefinition struct {
+	Name     string // 名称, 不区分大小写且唯一
+	Protocol P      // 协议版本
+	Code     uint32 // 数据标识, 2007为4字节, 1997为2字节
+	Format   string // 数据格式, 如 XXX.X
+	Size     int    // 数据长度
+	Unit     string // 单位
+	Signed   bool   // 最高字节的最高位是否为符号位, 仅2007有效
+}
+
+// dicAttr the attributes of the dic, the same as the attributes of the generated DIC enum
+type dicAttr struct {
+	name      string
+	old       uint16
+	oldFormat string
+	oldSize   int
+	newFormat string
+	newSize   int
+	unit      string
+	signed    bool
+}
+
+// dicRegistry the runtime registered dics, which are kept apart from the generated tables and consulted before them
+type dicRegistry struct {
+	lock     sync.RWMutex
+	attrs    map[DIC]*dicAttr
+	names    map[string]DIC // 小写的名称
+	values   []DIC          // 生成的表中没有的数据标识
+	decoders map[DIC]func(buf []byte) (any, error)
+}
+
+var registry = newDICRegistry()
+
+func newDICRegistry() *dicRegistry {
+	return &dicRegistry{attrs: map[DIC]*dicAttr{}, names: map[string]DIC{}, decoders: map[DIC]func(buf []byte) (any, error){}}
+}
+
+// clone return the copy of the registry, the lock must be held
+func (r *dicRegistry) clone() *dicRegistry {
+	ret := newDICRegistry()
+	for k, v := range r.attrs {
+		ret.attrs[k] = v
+	}
+	for k, v := range r.names {
+		ret.names[k] = v
+	}
+	for k, v := range r.decoders {
+		ret.decoders[k] = v
+	}
+	ret.values = append(ret.values, r.values...)
+	return ret
+}
+
+// commit replace the registered dics by the staged registry, the lock must be held
+func (r *dicRegistry) commit(staged *dicRegistry) {
+	r.attrs, r.names, r.values, r.decoders = staged.attrs, staged.names, staged.values, staged.decoders
+}
+
+// attr return the attribute of the registered or the generated dic, the lock must be held
+func (r *dicRegistry) attr(dic DIC) (dicAttr, bool) {
+	if a, ok := r.attrs[dic]; ok {
+		return *a, true
+	}
+
+	if !dic.IsValid() {
+		return rawAttr(dic), false
+	}
+
+	return dicAttr{
+		name:      dic.Name(),
+		old:       dic.Old(),
+		oldFormat: dic.OldFormat(),
+		oldSize:   dic.OldSize(),
+		newFormat: dic.NewFormat(),
+		newSize:   dic.NewSize(),
+		unit:      dic.Unit(),
+		signed:    dic.Signed(),
+	}, true
+}
+
+// parse return the registered or the generated dic of the case-insensitive name, the lock must be held
+func (r *dicRegistry) parse(name string) (DIC, bool) {
+	if dic, ok := r.names[strings.ToLower(name)]; ok {
+		return dic, true
+	}
+
+	dic, err := ParseDIC(name)
+	return dic, err == nil
+}
+
+// findOld return the dic which 1997 code is old, the lock must be held
+func (r *dicRegistry) findOld(old uint16) (DIC, bool) {
+	for _, v := range append(DICValues(), r.values...) {
+		if a, _ := r.attr(v); a.oldSize != 0 && a.old == old {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// set the attribute of the dic, the generated dic is overridden, the lock must be held
+func (r *dicRegistry) set(dic DIC, a dicAttr) {
+	if _, ok := r.attrs[dic]; !ok && !dic.IsValid() {
+		r.values = append(r.values, dic)
+	}
+
+	r.attrs[dic] = &a
+	r.names[strings.ToLower(a.name)] = dic
+}
+
+// attr return the attributes of the dic, the runtime registered dic is looked up before the generated dic
+func (dic DIC) attr() dicAttr {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	a, _ := registry.attr(dic)
+	return a
+}
+
+// name return the name of the registered or the generated dic
+func (dic DIC) name() string {
+	return dic.attr().name
+}
+
+// isKnown return true if the dic is registered or generated
+func (dic DIC) isKnown() bool {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	_, ok := registry.attr(dic)
+	return ok
+}
+
+// decoder return the decoder of the non-numeric data of the registered or the built-in dic
+func (dic DIC) decoder() (func(buf []byte) (any, error), bool) {
+	registry.lock.RLock()
+	decode, ok := registry.decoders[dic]
+	registry.lock.RUnlock()
+	if ok {
+		return decode, true
+	}
+
+	decode, ok = dataDecoders[dic]
+	return decode, ok
+}
+
+// allDICs return the generated and the registered dics
+func allDICs() []DIC {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	return append(append([]DIC{}, DICValues()...), registry.values...)
+}
+
+// supported return true if the attribute has the code of the protocol
+func (a *dicAttr) supported(protocol P) bool {
+	if protocol == PV2007 {
+		return a.newSize != 0
+	}
+	return a.oldSize != 0
+}
+
+func (d *DICDefinition) validate() error {
+	if d.Name == "" {
+		return errors.New("dic name is empty")
+	}
+
+	if d.Size <= 0 {
+		return fmt.Errorf("%s size must be more than 0", d.Name)
+	}
+
+	switch d.Protocol {
+	case PV2007:
+		if d.Code>>16 == 0xFFFF {
+			return fmt.Errorf("%s code 0x%08X is reserved for 1997", d.Name, d.Code)
+		}
+	case PV1997:
+		if d.Code > 0xFFFF || d.Code == 0xFFFF {
+			return fmt.Errorf("%s code 0x%X is not a 1997 code", d.Name, d.Code)
+		}
+	default:
+		return fmt.Errorf("%s protocol %d is invalid", d.Name, d.Protocol)
+	}
+
+	return nil
+}
+
+// findOldDIC return the dic which 1997 code is old
+func findOldDIC(old uint16) (DIC, bool) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	return registry.findOld(old)
+}
+
+// RegisterDIC register the dic at runtime, then it can be used by Client.Read like the generated dic.
+// The 2007 dic value is the code, the 1997 only dic value is 0xFFFF0000 | code.
+// If the name of the 1997 definition is already registered for 2007, the 1997 code is added to it.
+// The registered dics are kept apart from the generated tables, the generated Name, String, IsValid, ParseDIC and
+// DICValues only know the generated dics, use LookupDIC to find the registered dic by name, and the Name of the
+// read Value is the registered name.
+func RegisterDIC(d DICDefinition) (DIC, error) {
+	if err := d.validate(); err != nil {
+		return 0, err
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	return registry.register(d)
+}
+
+// register the valid definition, the lock must be held
+func (r *dicRegistry) register(d DICDefinition) (DIC, error) {
+	dic, exists := r.parse(d.Name)
+	a, _ := r.attr(dic)
+	if exists && a.supported(d.Protocol) {
+		return 0, fmt.Errorf("%s is already registered for %s", d.Name, d.Protocol)
+	}
+
+	if d.Protocol == PV2007 {
+		if exists {
+			return 0, fmt.Errorf("%s is already registered for %s", d.Name, PV1997)
+		}
+
+		dic = DIC(d.Code)
+		if other, ok := r.attr(dic); ok {
+			return 0, fmt.Errorf("code 0x%08X is already registered by %s", d.Code, other.name)
+		}
+
+		a = dicAttr{name: d.Name, old: 0xFFFF, newFormat: d.Format, newSize: d.Size, unit: d.Unit, signed: d.Signed}
+	} else {
+		if old, ok := r.findOld(uint16(d.Code)); ok {
+			other, _ := r.attr(old)
+			return 0, fmt.Errorf("code 0x%04X is already registered by %s", d.Code, other.name)
+		}
+
+		if !exists {
+			dic = DIC(0xFFFF0000 | d.Code)
+			if other, ok := r.attr(dic); ok {
+				return 0, fmt.Errorf("code 0x%04X is already registered by %s", d.Code, other.name)
+			}
+			a = dicAttr{name: d.Name, unit: d.Unit}
+		}
+
+		a.old = uint16(d.Code)
+		a.oldFormat = d.Format
+		a.oldSize = d.Size
+	}
+
+	r.set(dic, a)
+	return dic, nil
+}
+
+// rawDIC return the ad-hoc raw dic of the unknown code, the data of the raw dic is returned by Value.Raw.
+// The raw dic is not registered, its attributes are derived from the value by rawAttr.
+func rawDIC(code uint32, protocol P) DIC {
+	if protocol == PV2007 {
+		return DIC(code)
+	}
+	return DIC(0xFFFF0000 | code)
+}
+
+// rawAttr return the attribute of the unknown dic, the value with the 0xFFFF prefix is the 1997 raw dic,
+// others are the 2007 raw dic
+func rawAttr(dic DIC) dicAttr {
+	if dic>>16 == 0xFFFF {
+		return dicAttr{name: fmt.Sprintf("%04X", uint16(dic)), old: uint16(dic), oldSize: RawSize}
+	}
+	return dicAttr{name: fmt.Sprintf("%08X", uint32(dic)), old: 0xFFFF, newSize: RawSize}
+}
+
+// parseDIC return the registered or the generated dic of the case-insensitive name
+func parseDIC(name string) (DIC, bool) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	return registry.parse(name)
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// LookupDIC parse the dic of the protocol from the hex code or the name, like "02010100", "0x0201FF00", "B611" or "PhaseAVoltage".
+// The 8 digits hex is 2007 code, the 4 digits hex is 1997 code, the name is case-insensitive.
+// The unknown code of the protocol become the raw dic, which return the raw bytes when read.
+// It is not named ParseDIC, which is the generated parser of the generated dic names only, and the name follows
+// RegisterDIC and LoadDICFile of the dic registry.
+func LookupDIC(s string, protocol P) (DIC, error) {
+	s = strings.TrimSpace(s)
+
+	hex, prefixed := s, false
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		hex, prefixed = s[2:], true
+	}
+
+	var dic DIC
+	switch {
+	case isHex(hex) && (len(hex) == 8 || prefixed && len(hex) > 4 && len(hex) < 8):
+		code, err := parseHexCode(hex, 32)
+		if err != nil {
+			return 0, err
+		}
+
+		if dic = DIC(code); !dic.isKnown() {
+			if protocol != PV2007 {
+				return 0, fmt.Errorf("%s is %w", s, ErrInvalidDIC)
+			}
+			return rawDIC(code, protocol), nil
+		}
+	case isHex(hex) && (len(hex) == 4 || prefixed && len(hex) < 4):
+		code, err := parseHexCode(hex, 16)
+		if err != nil {
+			return 0, err
+		}
+
+		var ok bool
+		if dic, ok = findOldDIC(uint16(code)); !ok {
+			if protocol != PV1997 {
+				return 0, fmt.Errorf("%s is %w", s, ErrInvalidDIC)
+			}
+			return rawDIC(code, protocol), nil
+		}
+	default:
+		var ok bool
+		if dic, ok = parseDIC(s); !ok {
+			return 0, fmt.Errorf("%s is %w", s, ErrInvalidDIC)
+		}
+	}
+
+	if !dic.Supported(protocol) {
+		return 0, fmt.Errorf("%s unsupport %s", protocol, dic.name())
+	}
+
+	return dic, nil
+}
@@ -0,0 +1,120 @@
+package dlt645
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// resetRegistry clear the registered dics after the test, then the test can be run repeatedly
+func resetRegistry(t *testing.T) {
+	t.Cleanup(func() {
+		registry.lock.Lock()
+		defer registry.lock.Unlock()
+		registry.commit(newDICRegistry())
+	})
+}
+
+func TestRegistry_RegisterDIC(t *testing.T) {
+	resetRegistry(t)
+
+	dic, err := RegisterDIC(DICDefinition{Name: "VendorPhaseAEnergy", Protocol: PV2007, Code: 0x04800101, Format: "XXXXXX.XX", Size: 4, Unit: "kWh"})
+	assert.NoError(t, err)
+	assert.Equal(t, DIC(0x04800101), dic)
+	assert.Equal(t, "VendorPhaseAEnergy", dic.name())
+
+	// 运行时注册的数据标识不写入生成的表
+	assert.False(t, dic.IsValid())
+	found, err := LookupDIC("vendorPhaseAEnergy", PV2007)
+	assert.NoError(t, err)
+	assert.Equal(t, dic, found)
+
+	_, err = RegisterDIC(DICDefinition{Name: "VendorPhaseBEnergy", Protocol: PV2007, Code: 0x04800102, Format: "XXXXXX.XX", Size: 4, Unit: "kWh"})
+	assert.NoError(t, err)
+	block, err := RegisterDIC(DICDefinition{Name: "VendorEnergy", Protocol: PV2007, Code: 0x048001FF, Format: "XXXXXX.XX", Size: 4, Unit: "kWh"})
+	assert.NoError(t, err)
+
+	isBlock, dics := block.CheckBlock(PV2007)
+	assert.True(t, isBlock)
+	assert.Equal(t, []DIC{0x04800101, 0x04800102}, dics)
+
+	c := &client{}
+	values := c.getValue([]byte{0x78, 0x56, 0x34, 0x12, 0x01, 0x00, 0x00, 0x00}, block, PV2007)
+	assert.Len(t, values, 2)
+	assert.Equal(t, "123456.78kWh", values[0].Value.String()+values[0].Unit)
+	assert.Equal(t, "VendorPhaseBEnergy", values[1].Name)
+
+	// 同名的1997数据标识合并到已有的数据标识中
+	old, err := RegisterDIC(DICDefinition{Name: "vendorphaseaenergy", Protocol: PV1997, Code: 0xE011, Format: "XXXXXX.XX", Size: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, dic, old)
+	assert.Equal(t, []byte{0x11, 0xE0}, dic.Code(PV1997))
+	assert.Equal(t, "kWh", dic.attr().unit)
+
+	f, err := NewReadFrame("12345678", dic, PV2007)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x34, 0x34, 0xB3, 0x37}, f.Data)
+
+	_, err = RegisterDIC(DICDefinition{Name: "Vendor", Protocol: PV2007, Code: DICPhaseAVoltage.Val(), Size: 2})
+	assert.Error(t, err)
+	_, err = RegisterDIC(DICDefinition{Name: "phaseavoltage", Protocol: PV2007, Code: 0x04800201, Size: 2})
+	assert.Error(t, err)
+	_, err = RegisterDIC(DICDefinition{Name: "Vendor", Protocol: PV1997, Code: 0xB611, Size: 2})
+	assert.Error(t, err)
+	_, err = RegisterDIC(DICDefinition{Name: "Vendor", Protocol: PV1997, Code: 0x04800201, Size: 2})
+	assert.Error(t, err)
+}
+
+func TestRegistry_LookupDIC(t *testing.T) {
+	tests := []struct {
+		s        string
+		protocol P
+		exp      DIC
+	}{
+		{"02010100", PV2007, DICPhaseAVoltage},
+		{"0x0201FF00", PV2007, DICVoltage},
+		{"B611", PV1997, DICPhaseAVoltage},
+		{"b611", PV2007, DICPhaseAVoltage},
+		{"0xB61F", PV1997, DICVoltage},
+		{"PhaseAVoltage", PV1997, DICPhaseAVoltage},
+		{"phaseavoltage", PV2007, DICPhaseAVoltage},
+		{" TotalActiveEnergy ", PV2007, DICTotalActiveEnergy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			dic, err := LookupDIC(tt.s, tt.protocol)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.exp, dic)
+		})
+	}
+
+	_, err := LookupDIC("TotalActiveEnergy", PV1997)
+	assert.Error(t, err)
+	_, err = LookupDIC("NotExists", PV2007)
+	assert.Error(t, err)
+	_, err = LookupDIC("04800901", PV1997)
+	assert.Error(t, err)
+
+	// 未知的数据标识返回原始数据
+	raw, err := LookupDIC("0x04800901", PV2007)
+	assert.NoError(t, err)
+	assert.True(t, raw.IsRaw(PV2007))
+	assert.Equal(t, "04800901", raw.name())
+	assert.False(t, raw.isKnown())
+	assert.NotContains(t, allDICs(), raw)
+
+	again, err := LookupDIC("04800901", PV2007)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, again)
+
+	c := &client{}
+	values := c.getValue([]byte{0x01, 0x02, 0x03}, raw, PV2007)
+	assert.Len(t, values, 1)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03}, values[0].Raw)
+
+	old, err := LookupDIC("E901", PV1997)
+	assert.NoError(t, err)
+	assert.True(t, old.IsRaw(PV1997))
+	assert.Equal(t, []byte{0x01, 0xE9}, old.Code(PV1997))
+}
@@ -0,0 +1,98 @@
+package dlt645
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"os"
+	"time"
+)
+
+// RetryPolicy the retry policy of the request, the zero value is no retry
+type RetryPolicy struct {
+	MaxAttempts int                    // 最大尝试次数, 包含第一次请求, 小于1时为1
+	Backoff     time.Duration          // 第一次重试前的等待时间
+	MaxBackoff  time.Duration          // 等待时间的上限, 0为不限制
+	Multiplier  float64                // 每次重试等待时间的倍数, 小于1时为1
+	Jitter      float64                // 等待时间的随机抖动比例, 0~1
+	Retryable   func(err error) bool   // 判断错误是否需要重试, nil时使用 IsRetryable
+	OnAttempt   func(stat AttemptStat) // 每次尝试结束后的回调, 用于统计
+}
+
+// AttemptStat the statistics of one attempt of the request
+type AttemptStat struct {
+	Address  string        // 电表地址
+	Attempt  int           // 第几次尝试, 从1开始
+	Duration time.Duration // 本次尝试的耗时
+	Err      error         // 本次尝试的错误, 成功时为nil
+	Retry    bool          // 是否还会重试
+}
+
+// IsRetryable return true if the request may succeed by retry, which is only the timeout of the response, including
+// the inter-byte timeout, and the cs error caused by the noise of the bus. the error response of the meter, such as
+// ErrorCodePD and ErrorCodeDATA, the done ctx, ErrEcho, the disconnection and the invalid request are not retried
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, ErrCS) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff return the waiting time before the retry, retry is from 1
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p.Backoff <= 0 {
+		return 0
+	}
+
+	multiplier := math.Max(p.Multiplier, 1)
+	d := float64(p.Backoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+		d += d * jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// wait sleep the backoff time of the retry, return the ctx error if the ctx is done
+func (p *RetryPolicy) wait(ctx context.Context, retry int) error {
+	d := p.backoff(retry)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
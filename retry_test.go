@@ -0,0 +1,105 @@
+package dlt645
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry_IsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(ErrCS))
+	assert.True(t, IsRetryable(os.ErrDeadlineExceeded))
+	assert.True(t, IsRetryable(fmt.Errorf("inter-byte timeout %s: %w", time.Millisecond, os.ErrDeadlineExceeded)))
+	assert.True(t, IsRetryable(&net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}))
+	assert.False(t, IsRetryable(context.DeadlineExceeded))
+	assert.False(t, IsRetryable(fmt.Errorf("%w: % X", ErrEcho, []byte{0x68})))
+	assert.False(t, IsRetryable(errors.New("tcp transporter not connected")))
+	assert.False(t, IsRetryable(io.EOF))
+	assert.False(t, IsRetryable(nil))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(&FrameError{Code: ErrorCodePD}))
+	assert.False(t, IsRetryable(&FrameError{Code: ErrorCodeDATA}))
+}
+
+func TestRetry_Backoff(t *testing.T) {
+	p := &RetryPolicy{Backoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.backoff(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestRetry_Request(t *testing.T) {
+	requests := 0
+	tr := newTestTransporter(t, func(req []byte) [][]byte {
+		requests++
+		resp := testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 0)
+		switch requests {
+		case 1:
+			// 总线干扰导致的校验错误
+			resp[len(resp)-2]++
+		case 2:
+			// 超时无应答
+			return nil
+		}
+		return [][]byte{resp}
+	})
+
+	var stats []AttemptStat
+	c := NewClient(tr, WithPreambles(0), WithTimeout(20*time.Millisecond), WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		OnAttempt:   func(stat AttemptStat) { stats = append(stats, stat) },
+	}))
+
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	assert.Len(t, stats, 3)
+	assert.ErrorIs(t, stats[0].Err, ErrCS)
+	assert.True(t, stats[0].Retry)
+	assert.Error(t, stats[1].Err)
+	assert.Equal(t, "1", stats[2].Address)
+	assert.Equal(t, 3, stats[2].Attempt)
+	assert.NoError(t, stats[2].Err)
+	assert.False(t, stats[2].Retry)
+
+	// 无请求数据的异常应答不重试
+	requests, stats = 0, nil
+	tr.Handle(func(req []byte) [][]byte {
+		requests++
+		return [][]byte{testFrame("1", 0xD1, []byte{byte(ErrorCodeDATA)}, 0)}
+	})
+	v = c.Read("1", DICPhaseAVoltage)
+	assert.True(t, errors.Is(v[0].Err, ErrorCodeDATA))
+	assert.Equal(t, 1, requests)
+	assert.Len(t, stats, 1)
+	assert.False(t, stats[0].Retry)
+}
+
+func TestRetry_CancelBackoff(t *testing.T) {
+	tr := newTestTransporter(t, noResponse)
+	c := NewClient(tr, WithTimeout(20*time.Millisecond), WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: time.Minute}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	// 等待重试时取消, 返回取消的错误和最后一次请求的错误
+	v := c.ReadContext(ctx, "1", DICPhaseAVoltage)
+	assert.ErrorIs(t, v[0].Err, context.Canceled)
+	assert.ErrorIs(t, v[0].Err, os.ErrDeadlineExceeded)
+}
@@ -0,0 +1,229 @@
+package dlt645
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// dataDecoders decode the non-numeric data of dic into Value.Data
+var dataDecoders = map[DIC]func(buf []byte) (any, error){
+	DICRunningStatusWord1:     decodeBitsFunc[StatusWord1](),
+	DICRunningStatusWord2:     decodeBitsFunc[StatusWord2](),
+	DICRunningStatusWord3:     decodeBitsFunc[StatusWord3](),
+	DICRunningStatusWord4:     decodeBitsFunc[PhaseFaultStatus](),
+	DICRunningStatusWord5:     decodeBitsFunc[PhaseFaultStatus](),
+	DICRunningStatusWord6:     decodeBitsFunc[PhaseFaultStatus](),
+	DICRunningStatusWord7:     decodeBitsFunc[StatusWord7](),
+	DICActiveReportStatusWord: decodeBitsFunc[ActiveReportStatus](),
+}
+
+// StatusWord1 电表运行状态字1
+type StatusWord1 struct {
+	DemandInterval       bool `bit:"1"`  // 需量积算方式, false: 滑差, true: 区间
+	ClockBatteryLow      bool `bit:"2"`  // 时钟电池欠压
+	ReadingBatteryLow    bool `bit:"3"`  // 停电抄表电池欠压
+	ReverseActivePower   bool `bit:"4"`  // 有功功率反向
+	ReverseReactivePower bool `bit:"5"`  // 无功功率反向
+	ControlLoopError     bool `bit:"8"`  // 控制回路错误
+	ESAMError            bool `bit:"9"`  // ESAM错误
+	InternalProgramError bool `bit:"12"` // 内部程序错误
+	MemoryFault          bool `bit:"13"` // 存储器故障或损坏
+	Overdraft            bool `bit:"14"` // 透支状态
+	ClockFault           bool `bit:"15"` // 时钟故障
+}
+
+// StatusWord2 电表运行状态字2, 各相功率方向
+type StatusWord2 struct {
+	PhaseAReverseActivePower   bool `bit:"0"` // A相有功功率反向
+	PhaseBReverseActivePower   bool `bit:"1"` // B相有功功率反向
+	PhaseCReverseActivePower   bool `bit:"2"` // C相有功功率反向
+	PhaseAReverseReactivePower bool `bit:"4"` // A相无功功率反向
+	PhaseBReverseReactivePower bool `bit:"5"` // B相无功功率反向
+	PhaseCReverseReactivePower bool `bit:"6"` // C相无功功率反向
+}
+
+// StatusWord3 电表运行状态字3, 操作类
+type StatusWord3 struct {
+	SecondTimePeriodTable bool  `bit:"0"`   // 当前运行时段, false: 第一套, true: 第二套
+	PowerSupply           uint8 `bit:"1,2"` // 供电方式, 0: 主电源, 1: 辅助电源, 2: 电池供电
+	ProgramEnabled        bool  `bit:"3"`   // 编程允许
+	RelayOpen             bool  `bit:"4"`   // 继电器状态, false: 通, true: 断
+	SecondTimeZoneTable   bool  `bit:"5"`   // 当前运行时区, false: 第一套, true: 第二套
+	RelayCommandOpen      bool  `bit:"6"`   // 继电器命令状态, false: 通, true: 断
+	PreTripAlarm          bool  `bit:"7"`   // 预跳闸报警
+	MeterType             uint8 `bit:"8,2"` // 电能表类型, 0: 非预付费, 1: 电量型预付费, 2: 电费型预付费
+	SecondTariff          bool  `bit:"10"`  // 当前运行分时费率, false: 第一套, true: 第二套
+	SecondStep            bool  `bit:"11"`  // 当前阶梯, false: 第一套, true: 第二套
+	PowerGuard            bool  `bit:"12"`  // 保电状态
+	Authenticated         bool  `bit:"13"`  // 身份认证状态
+	LocalUnopened         bool  `bit:"14"`  // 本地未开户
+	RemoteUnopened        bool  `bit:"15"`  // 远程未开户
+}
+
+// PhaseFaultStatus 电表运行状态字4/5/6, A/B/C相故障状态
+type PhaseFaultStatus struct {
+	LossOfVoltage bool `bit:"0"` // 失压
+	UnderVoltage  bool `bit:"1"` // 欠压
+	OverVoltage   bool `bit:"2"` // 过压
+	LossOfCurrent bool `bit:"3"` // 失流
+	OverCurrent   bool `bit:"4"` // 过流
+	Overload      bool `bit:"5"` // 过载
+	ReversePower  bool `bit:"6"` // 潮流反向
+	PhaseBreak    bool `bit:"7"` // 断相
+	CurrentBreak  bool `bit:"8"` // 断流
+}
+
+// StatusWord7 电表运行状态字7, 合相故障状态
+type StatusWord7 struct {
+	VoltageReverseSequence bool `bit:"0"`  // 电压逆相序
+	CurrentReverseSequence bool `bit:"1"`  // 电流逆相序
+	VoltageUnbalance       bool `bit:"2"`  // 电压不平衡
+	CurrentUnbalance       bool `bit:"3"`  // 电流不平衡
+	AuxPowerLoss           bool `bit:"4"`  // 辅助电源失电
+	PowerDown              bool `bit:"5"`  // 掉电
+	DemandOverLimit        bool `bit:"6"`  // 需量超限
+	PowerFactorUnderLimit  bool `bit:"7"`  // 总功率因数超下限
+	CurrentSevereUnbalance bool `bit:"8"`  // 电流严重不平衡
+	ReversePower           bool `bit:"9"`  // 潮流反向
+	TotalLossOfVoltage     bool `bit:"10"` // 全失压
+}
+
+// RunningStatus 电表运行状态字1-7
+type RunningStatus struct {
+	Word1  StatusWord1      `bit:"0"`
+	Word2  StatusWord2      `bit:"16"`
+	Word3  StatusWord3      `bit:"32"`
+	PhaseA PhaseFaultStatus `bit:"48"` // 状态字4
+	PhaseB PhaseFaultStatus `bit:"64"` // 状态字5
+	PhaseC PhaseFaultStatus `bit:"80"` // 状态字6
+	Word7  StatusWord7      `bit:"96"`
+}
+
+// ActiveReportStatus 主动上报状态字
+type ActiveReportStatus struct {
+	ControlLoopError     bool             `bit:"0"`  // 控制回路错误
+	ESAMError            bool             `bit:"1"`  // ESAM错误
+	CardInitError        bool             `bit:"2"`  // 内卡初始化错误
+	ClockBatteryLow      bool             `bit:"3"`  // 时钟电池电压低
+	InternalProgramError bool             `bit:"4"`  // 内部程序错误
+	MemoryFault          bool             `bit:"5"`  // 存储器故障或损坏
+	ClockFault           bool             `bit:"7"`  // 时钟故障
+	ReadingBatteryLow    bool             `bit:"8"`  // 停电抄表电池欠压
+	Overdraft            bool             `bit:"9"`  // 透支状态
+	MeterCoverOpen       bool             `bit:"10"` // 开表盖
+	TerminalCoverOpen    bool             `bit:"11"` // 开端钮盖
+	MagneticInterference bool             `bit:"12"` // 恒定磁场干扰
+	PowerSupplyAbnormal  bool             `bit:"13"` // 电源异常
+	TripSuccess          bool             `bit:"14"` // 跳闸成功
+	CloseSuccess         bool             `bit:"15"` // 合闸成功
+	PhaseA               PhaseFaultStatus `bit:"16"` // A相故障
+	PhaseB               PhaseFaultStatus `bit:"32"` // B相故障
+	PhaseC               PhaseFaultStatus `bit:"48"` // C相故障
+	Combined             StatusWord7      `bit:"64"` // 合相故障
+}
+
+// getBits return n bits from the start bit, the buf is low byte first
+func getBits(buf []byte, start, n int) (ret uint64) {
+	for i := n - 1; i >= 0; i-- {
+		bit := start + i
+		ret <<= 1
+		if bit/8 < len(buf) && buf[bit/8]&(1<<(bit%8)) != 0 {
+			ret |= 1
+		}
+	}
+	return ret
+}
+
+func parseBitTag(tag string) (start, n int, err error) {
+	parts := strings.Split(tag, ",")
+	if start, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, err
+	}
+	n = 1
+	if len(parts) > 1 {
+		if n, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, err
+		}
+	}
+	return start, n, nil
+}
+
+// decodeBits fill the fields of v by the `bit:"start[,len]"` tag, nested struct start with the offset
+func decodeBits(buf []byte, offset int, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup("bit")
+		if !ok {
+			continue
+		}
+
+		start, n, err := parseBitTag(tag)
+		if err != nil {
+			return fmt.Errorf("invalid bit tag of %s.%s: %w", t.Name(), t.Field(i).Name, err)
+		}
+
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Bool:
+			field.SetBool(getBits(buf, offset+start, 1) != 0)
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			field.SetUint(getBits(buf, offset+start, n))
+		case reflect.Struct:
+			if err = decodeBits(buf, offset+start, field); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported bit field type: %s.%s", t.Name(), t.Field(i).Name)
+		}
+	}
+	return nil
+}
+
+func decodeBitsFunc[T any]() func(buf []byte) (any, error) {
+	return func(buf []byte) (any, error) {
+		ret := new(T)
+		if err := decodeBits(buf, 0, reflect.ValueOf(ret).Elem()); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	}
+}
+
+func (c *client) ReadRunningStatus(addr string) (*RunningStatus, error) {
+	data, err := c.readData(addr, DICRunningStatusWord)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 14 {
+		return nil, fmt.Errorf("running status data length %d is less than 14", len(data))
+	}
+
+	ret := &RunningStatus{}
+	if err = decodeBits(data, 0, reflect.ValueOf(ret).Elem()); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (c *client) ReadActiveReportStatus(addr string) (*ActiveReportStatus, error) {
+	data, err := c.readData(addr, DICActiveReportStatusWord)
+	if err != nil {
+		return nil, err
+	}
+
+	size := DICActiveReportStatusWord.Size(c.protocol(addr))
+	if len(data) < size {
+		return nil, fmt.Errorf("active report status data length %d is less than %d", len(data), size)
+	}
+
+	ret := &ActiveReportStatus{}
+	if err = decodeBits(data, 0, reflect.ValueOf(ret).Elem()); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
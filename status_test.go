@@ -0,0 +1,57 @@
+package dlt645
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_decodeBits(t *testing.T) {
+	v, err := dataDecoders[DICRunningStatusWord1]([]byte{0x14, 0x82})
+	assert.NoError(t, err)
+	assert.Equal(t, &StatusWord1{ClockBatteryLow: true, ReverseActivePower: true, ESAMError: true, ClockFault: true}, v)
+
+	v, err = dataDecoders[DICRunningStatusWord3]([]byte{0x94, 0x01})
+	assert.NoError(t, err)
+	assert.Equal(t, &StatusWord3{PowerSupply: 2, RelayOpen: true, PreTripAlarm: true, MeterType: 1}, v)
+}
+
+func TestStatus_RunningStatus(t *testing.T) {
+	data := []byte{
+		0x04, 0x00, // 状态字1
+		0x02, 0x00, // 状态字2
+		0x10, 0x00, // 状态字3
+		0x01, 0x00, // 状态字4
+		0x00, 0x01, // 状态字5
+		0x40, 0x00, // 状态字6
+		0x00, 0x04, // 状态字7
+	}
+
+	ret := &RunningStatus{}
+	assert.NoError(t, decodeBits(data, 0, reflect.ValueOf(ret).Elem()))
+	assert.Equal(t, &RunningStatus{
+		Word1:  StatusWord1{ClockBatteryLow: true},
+		Word2:  StatusWord2{PhaseBReverseActivePower: true},
+		Word3:  StatusWord3{RelayOpen: true},
+		PhaseA: PhaseFaultStatus{LossOfVoltage: true},
+		PhaseB: PhaseFaultStatus{CurrentBreak: true},
+		PhaseC: PhaseFaultStatus{ReversePower: true},
+		Word7:  StatusWord7{TotalLossOfVoltage: true},
+	}, ret)
+}
+
+func TestStatus_ActiveReportStatus(t *testing.T) {
+	data := make([]byte, 12)
+	data[1] = 0x04 // 开表盖
+	data[4] = 0x01 // B相失压
+	data[8] = 0x20 // 掉电
+
+	v, err := dataDecoders[DICActiveReportStatusWord](data)
+	assert.NoError(t, err)
+	assert.Equal(t, &ActiveReportStatus{
+		MeterCoverOpen: true,
+		PhaseB:         PhaseFaultStatus{LossOfVoltage: true},
+		Combined:       StatusWord7{PowerDown: true},
+	}, v)
+}
@@ -0,0 +1,81 @@
+package dlt645
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"time"
+)
+
+// stream the in-memory read stream of the transporter, the data is written by the other goroutine, such as the
+// connection of the dtu or the mock meter, the blocking read is woken by the data, the wake or the timeout
+type stream struct {
+	lock   sync.Mutex
+	buf    bytes.Buffer
+	notify chan struct{}
+}
+
+func newStream() *stream {
+	return &stream{notify: make(chan struct{}, 1)}
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// write append the data to the stream and wake the blocking read
+func (s *stream) write(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	s.buf.Write(data)
+	s.lock.Unlock()
+
+	signal(s.notify)
+}
+
+// reset discard the unread data
+func (s *stream) reset() {
+	s.lock.Lock()
+	s.buf.Reset()
+	s.lock.Unlock()
+}
+
+// wake the blocking read to check the state again, such as the disconnection or the abort
+func (s *stream) wake() {
+	signal(s.notify)
+}
+
+// read the data of the stream, block until the data is written, the check return the error or timeout.
+// the check is called before blocking, its error is returned, such as the disconnection or the abort
+func (s *stream) read(buf []byte, timeout time.Duration, check func() error) (int, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		s.lock.Lock()
+		if s.buf.Len() > 0 {
+			n, _ := s.buf.Read(buf)
+			s.lock.Unlock()
+			return n, nil
+		}
+		s.lock.Unlock()
+
+		if check != nil {
+			if err := check(); err != nil {
+				return 0, err
+			}
+		}
+
+		select {
+		case <-s.notify:
+		case <-timer.C:
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package dlt645
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultBaudRate     = 2400 // 标准规定的缺省通信速率
+	BitsPerChar         = 11   // 8E1: 起始位, 8个数据位, 偶校验位, 停止位
+	MinTurnaround       = 20 * time.Millisecond
+	MinInterByteTimeout = 50 * time.Millisecond
+)
+
+// Timing the timing of the rs485 bus, the zero duration has no limit
+type Timing struct {
+	IdleGap          time.Duration // 发送前总线的最小空闲时间
+	Turnaround       time.Duration // 收到电表的应答后, 到下一次发送的最小间隔
+	InterByteTimeout time.Duration // 帧内字节间的超时, 超时后认为应答帧不完整
+	FrameTimeout     time.Duration // 等待完整应答帧的超时, 客户端没有设置超时时使用
+}
+
+// NewTiming return the default timing of the baud rate, the timing is at least MinTurnaround and MinInterByteTimeout,
+// so the meter which drop the request arrived within 20ms of its last reply works
+func NewTiming(baud int) Timing {
+	if baud <= 0 {
+		baud = DefaultBaudRate
+	}
+
+	char := time.Duration(BitsPerChar) * time.Second / time.Duration(baud)
+
+	return Timing{
+		IdleGap:          maxDuration(char*7/2, time.Millisecond),
+		Turnaround:       maxDuration(char*10, MinTurnaround),
+		InterByteTimeout: maxDuration(char*32, MinInterByteTimeout),
+		FrameTimeout:     DefaultResponseTimeout * time.Millisecond,
+	}
+}
+
+// waitIdle wait until the bus is idle for the IdleGap and the Turnaround after the last reply
+func (b *bus) waitIdle(ctx context.Context, timing Timing) error {
+	next := b.lastTx
+	if b.lastRx.After(next) {
+		next = b.lastRx
+	}
+	next = next.Add(timing.IdleGap)
+
+	if turnaround := b.lastRx.Add(timing.Turnaround); turnaround.After(next) {
+		next = turnaround
+	}
+
+	d := time.Until(next)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
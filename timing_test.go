@@ -0,0 +1,45 @@
+package dlt645
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTiming_NewTiming(t *testing.T) {
+	timing := NewTiming(0)
+	assert.Equal(t, NewTiming(DefaultBaudRate), timing)
+	assert.Equal(t, 16041665*time.Nanosecond, timing.IdleGap)
+	assert.Equal(t, 45833330*time.Nanosecond, timing.Turnaround)
+	assert.Equal(t, 146666656*time.Nanosecond, timing.InterByteTimeout)
+	assert.Equal(t, DefaultResponseTimeout*time.Millisecond, timing.FrameTimeout)
+
+	timing = NewTiming(115200)
+	assert.Equal(t, time.Millisecond, timing.IdleGap)
+	assert.Equal(t, MinTurnaround, timing.Turnaround)
+	assert.Equal(t, MinInterByteTimeout, timing.InterByteTimeout)
+}
+
+func TestTiming_Turnaround(t *testing.T) {
+	var writes []time.Time
+	tr := newTestTransporter(t, func(req []byte) [][]byte {
+		writes = append(writes, time.Now())
+		return [][]byte{testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 0)}
+	})
+	tr.Timing = Timing{Turnaround: 30 * time.Millisecond, IdleGap: 5 * time.Millisecond}
+
+	c := NewClient(tr, WithPreambles(0))
+	c.Read("1", DICPhaseAVoltage)
+	c.Read("1", DICPhaseAVoltage)
+	assert.Len(t, writes, 2)
+	assert.GreaterOrEqual(t, writes[1].Sub(writes[0]), 30*time.Millisecond)
+
+	// 等待总线空闲时取消
+	b := &bus{lastTx: time.Now()}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, b.waitIdle(ctx, Timing{IdleGap: time.Second}), context.Canceled)
+	assert.NoError(t, b.waitIdle(context.Background(), Timing{}))
+}
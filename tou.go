@@ -0,0 +1,385 @@
+package dlt645
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	MaxTimeZones   = 14  // 年时区数最大值
+	MaxDailyTables = 8   // 日时段表数最大值
+	MaxPeriods     = 14  // 日时段数最大值
+	MaxTariffs     = 63  // 费率数最大值
+	MaxHolidays    = 254 // 公共假日数最大值
+)
+
+// TOUParams 时区时段参数
+type TOUParams struct {
+	TimeZones   int // 年时区数
+	DailyTables int // 日时段表数
+	Periods     int // 日时段数
+	Tariffs     int // 费率数
+	Holidays    int // 公共假日数
+}
+
+// TimeZone 年时区, 从起始月日开始使用日时段表
+type TimeZone struct {
+	Month      int // 起始月
+	Day        int // 起始日
+	DailyTable int // 日时段表号
+}
+
+// TimePeriod 日时段, 从起始时分开始使用费率
+type TimePeriod struct {
+	Hour   int // 起始时
+	Minute int // 起始分
+	Tariff int // 费率号
+}
+
+// Holiday 公共假日
+type Holiday struct {
+	Year       int // 年, 两位
+	Month      int // 月
+	Day        int // 日
+	DailyTable int // 日时段表号
+}
+
+// TOUSwitchTimes 两套表的切换时间
+type TOUSwitchTimes struct {
+	TimeZone   time.Time // 两套时区表切换时间
+	DailyTable time.Time // 两套日时段表切换时间
+	Tariff     time.Time // 两套分时费率切换时间
+	Step       time.Time // 两套阶梯切换时间
+}
+
+func checkTableSet(set int) error {
+	if set != 1 && set != 2 {
+		return fmt.Errorf("table set must be 1 or 2, but got %d", set)
+	}
+	return nil
+}
+
+func timeZoneTableDIC(set int) DIC {
+	if set == 1 {
+		return DICFirstTimeZoneTable
+	}
+	return DICSecondTimeZoneTable
+}
+
+func dailyTableDIC(set, table int) DIC {
+	if set == 1 {
+		return DIC(DICFirstDailyTable1.Val() + uint32(table-1))
+	}
+	return DIC(DICSecondDailyTable1.Val() + uint32(table-1))
+}
+
+// decodeTriples split the data to 3 bytes bcd values, like MMDDNN or hhmmNN
+func decodeTriples(data []byte) (ret [][3]int) {
+	for i := 0; i+3 <= len(data); i += 3 {
+		v := bcdToUint(data[i:i+3], 3)
+		ret = append(ret, [3]int{int(v / 10000), int(v / 100 % 100), int(v % 100)})
+	}
+	return ret
+}
+
+func encodeTriple(a, b, c int) []byte {
+	return uintToBcd(uint64(a)*10000+uint64(b)*100+uint64(c), 3)
+}
+
+// Validate check the params before writing. the error of the exceeded count wraps the ErrorCode, such as
+// ErrorCodeYEAR, but it is not the FrameError, which is the error response of the meter
+func (p *TOUParams) Validate() error {
+	if p.TimeZones < 1 || p.TimeZones > MaxTimeZones {
+		return fmt.Errorf("%w: time zones must be between 1 and %d", ErrorCodeYEAR, MaxTimeZones)
+	}
+	if p.DailyTables < 1 || p.DailyTables > MaxDailyTables {
+		return fmt.Errorf("%w: daily tables must be between 1 and %d", ErrorCodeDAY, MaxDailyTables)
+	}
+	if p.Periods < 1 || p.Periods > MaxPeriods {
+		return fmt.Errorf("%w: periods must be between 1 and %d", ErrorCodeDAY, MaxPeriods)
+	}
+	if p.Tariffs < 1 || p.Tariffs > MaxTariffs {
+		return fmt.Errorf("%w: tariffs must be between 1 and %d", ErrorCodeRATE, MaxTariffs)
+	}
+	if p.Holidays < 0 || p.Holidays > MaxHolidays {
+		return fmt.Errorf("holidays must be between 0 and %d", MaxHolidays)
+	}
+	return nil
+}
+
+func validateTimeZones(zones []TimeZone) error {
+	if len(zones) < 1 || len(zones) > MaxTimeZones {
+		return fmt.Errorf("%w: time zones must be between 1 and %d", ErrorCodeYEAR, MaxTimeZones)
+	}
+	for _, z := range zones {
+		if z.Month < 1 || z.Month > 12 || z.Day < 1 || z.Day > 31 {
+			return fmt.Errorf("invalid time zone date: %02d-%02d", z.Month, z.Day)
+		}
+		if z.DailyTable < 1 || z.DailyTable > MaxDailyTables {
+			return fmt.Errorf("%w: daily table must be between 1 and %d", ErrorCodeDAY, MaxDailyTables)
+		}
+	}
+	return nil
+}
+
+func validateTimePeriods(periods []TimePeriod) error {
+	if len(periods) < 1 || len(periods) > MaxPeriods {
+		return fmt.Errorf("%w: periods must be between 1 and %d", ErrorCodeDAY, MaxPeriods)
+	}
+	for _, p := range periods {
+		if p.Hour < 0 || p.Hour > 23 || p.Minute < 0 || p.Minute > 59 {
+			return fmt.Errorf("invalid time period: %02d:%02d", p.Hour, p.Minute)
+		}
+		if p.Tariff < 1 || p.Tariff > MaxTariffs {
+			return fmt.Errorf("%w: tariff must be between 1 and %d", ErrorCodeRATE, MaxTariffs)
+		}
+	}
+	return nil
+}
+
+func (c *client) checkTOUProtocol(addr string) error {
+	if c.protocol(addr) != PV2007 {
+		return errors.New("1997 unsupport time-of-use tables")
+	}
+	return nil
+}
+
+func (c *client) ReadTOUParams(addr string) (*TOUParams, error) {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return nil, err
+	}
+
+	p := &TOUParams{}
+	fields := []struct {
+		dic   DIC
+		value *int
+	}{
+		{DICTimeZoneCount, &p.TimeZones},
+		{DICDailyTableCount, &p.DailyTables},
+		{DICPeriodCount, &p.Periods},
+		{DICTariffCount, &p.Tariffs},
+		{DICHolidayCount, &p.Holidays},
+	}
+
+	for _, f := range fields {
+		v, err := c.readDecimal(addr, f.dic)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed: %w", f.dic, err)
+		}
+		*f.value = int(v.IntPart())
+	}
+
+	return p, nil
+}
+
+// WriteTOUParams write the five counts of the params one by one, the writing is not atomic, the meter keeps the
+// counts written before the failed one
+func (c *client) WriteTOUParams(addr string, password *Password, p *TOUParams) error {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return err
+	}
+
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		dic   DIC
+		value int
+	}{
+		{DICTimeZoneCount, p.TimeZones},
+		{DICDailyTableCount, p.DailyTables},
+		{DICPeriodCount, p.Periods},
+		{DICTariffCount, p.Tariffs},
+		{DICHolidayCount, p.Holidays},
+	}
+
+	for _, f := range fields {
+		if err := c.writeData(addr, f.dic, password, uintToBcd(uint64(f.value), f.dic.Size(PV2007))); err != nil {
+			return fmt.Errorf("write %s failed: %w", f.dic, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) ReadTimeZoneTable(addr string, set int) (zones []TimeZone, err error) {
+	if err = c.checkTOUProtocol(addr); err != nil {
+		return nil, err
+	}
+
+	if err = checkTableSet(set); err != nil {
+		return nil, err
+	}
+
+	data, err := c.readData(addr, timeZoneTableDIC(set))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range decodeTriples(data) {
+		zones = append(zones, TimeZone{Month: t[0], Day: t[1], DailyTable: t[2]})
+	}
+
+	return zones, nil
+}
+
+func (c *client) WriteTimeZoneTable(addr string, password *Password, set int, zones []TimeZone) error {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return err
+	}
+
+	if err := checkTableSet(set); err != nil {
+		return err
+	}
+
+	if err := validateTimeZones(zones); err != nil {
+		return err
+	}
+
+	var data []byte
+	for _, z := range zones {
+		data = append(data, encodeTriple(z.Month, z.Day, z.DailyTable)...)
+	}
+
+	return c.writeData(addr, timeZoneTableDIC(set), password, data)
+}
+
+func (c *client) ReadDailyTable(addr string, set, table int) (periods []TimePeriod, err error) {
+	if err = c.checkTOUProtocol(addr); err != nil {
+		return nil, err
+	}
+
+	if err = checkTableSet(set); err != nil {
+		return nil, err
+	}
+
+	if table < 1 || table > MaxDailyTables {
+		return nil, fmt.Errorf("daily table must be between 1 and %d", MaxDailyTables)
+	}
+
+	data, err := c.readData(addr, dailyTableDIC(set, table))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range decodeTriples(data) {
+		periods = append(periods, TimePeriod{Hour: t[0], Minute: t[1], Tariff: t[2]})
+	}
+
+	return periods, nil
+}
+
+func (c *client) WriteDailyTable(addr string, password *Password, set, table int, periods []TimePeriod) error {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return err
+	}
+
+	if err := checkTableSet(set); err != nil {
+		return err
+	}
+
+	if table < 1 || table > MaxDailyTables {
+		return fmt.Errorf("%w: daily table must be between 1 and %d", ErrorCodeDAY, MaxDailyTables)
+	}
+
+	if err := validateTimePeriods(periods); err != nil {
+		return err
+	}
+
+	var data []byte
+	for _, p := range periods {
+		data = append(data, encodeTriple(p.Hour, p.Minute, p.Tariff)...)
+	}
+
+	return c.writeData(addr, dailyTableDIC(set, table), password, data)
+}
+
+func (c *client) ReadHoliday(addr string, n int) (*Holiday, error) {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return nil, err
+	}
+
+	if n < 1 || n > MaxHolidays {
+		return nil, fmt.Errorf("holiday must be between 1 and %d", MaxHolidays)
+	}
+
+	data, err := c.readLastN(addr, DICHoliday1, n)
+	if err != nil {
+		return nil, err
+	}
+
+	v := bcdToUint(data, DICHoliday1.Size(PV2007))
+	return &Holiday{Year: int(v / 1000000), Month: int(v / 10000 % 100), Day: int(v / 100 % 100), DailyTable: int(v % 100)}, nil
+}
+
+func (c *client) WriteHoliday(addr string, password *Password, n int, h *Holiday) error {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return err
+	}
+
+	if n < 1 || n > MaxHolidays {
+		return fmt.Errorf("holiday must be between 1 and %d", MaxHolidays)
+	}
+
+	if h.Year < 0 || h.Year > 99 || h.Month < 1 || h.Month > 12 || h.Day < 1 || h.Day > 31 {
+		return fmt.Errorf("invalid holiday date: %02d-%02d-%02d", h.Year, h.Month, h.Day)
+	}
+
+	if h.DailyTable < 1 || h.DailyTable > MaxDailyTables {
+		return fmt.Errorf("%w: daily table must be between 1 and %d", ErrorCodeDAY, MaxDailyTables)
+	}
+
+	value := uint64(h.Year)*1000000 + uint64(h.Month)*10000 + uint64(h.Day)*100 + uint64(h.DailyTable)
+	return c.writeData(addr, DIC(DICHoliday1.Val()+uint32(n-1)), password, uintToBcd(value, DICHoliday1.Size(PV2007)))
+}
+
+func (c *client) ReadTOUSwitchTimes(addr string) (*TOUSwitchTimes, error) {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return nil, err
+	}
+
+	s := &TOUSwitchTimes{}
+	fields := []struct {
+		dic   DIC
+		value *time.Time
+	}{
+		{DICTimeZoneSwitchTime, &s.TimeZone},
+		{DICDailyTableSwitchTime, &s.DailyTable},
+		{DICTariffSwitchTime, &s.Tariff},
+		{DICStepSwitchTime, &s.Step},
+	}
+
+	for _, f := range fields {
+		data, err := c.readData(addr, f.dic)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed: %w", f.dic, err)
+		}
+
+		if len(data) < f.dic.Size(PV2007) {
+			return nil, fmt.Errorf("%s data length %d is less than %d", f.dic, len(data), f.dic.Size(PV2007))
+		}
+
+		if *f.value, err = bcdToTime(data[:f.dic.Size(PV2007)], c.location); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// WriteTOUSwitchTime write one of the switch time dic, like DICTimeZoneSwitchTime
+func (c *client) WriteTOUSwitchTime(addr string, password *Password, dic DIC, t time.Time) error {
+	if err := c.checkTOUProtocol(addr); err != nil {
+		return err
+	}
+
+	switch dic {
+	case DICTimeZoneSwitchTime, DICDailyTableSwitchTime, DICTariffSwitchTime, DICStepSwitchTime:
+	default:
+		return fmt.Errorf("%s is not a switch time", dic)
+	}
+
+	return c.writeData(addr, dic, password, timeToBcd(t, dic.Size(PV2007)))
+}
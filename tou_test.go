@@ -0,0 +1,32 @@
+package dlt645
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOU_decodeTriples(t *testing.T) {
+	data := append(encodeTriple(1, 1, 1), encodeTriple(6, 15, 2)...)
+	assert.Equal(t, []byte{0x01, 0x01, 0x01, 0x02, 0x15, 0x06}, data)
+	assert.Equal(t, [][3]int{{1, 1, 1}, {6, 15, 2}}, decodeTriples(data))
+}
+
+func TestTOU_Validate(t *testing.T) {
+	assert.NoError(t, (&TOUParams{TimeZones: 2, DailyTables: 2, Periods: 8, Tariffs: 4}).Validate())
+	assert.True(t, errors.Is((&TOUParams{TimeZones: 15, DailyTables: 2, Periods: 8, Tariffs: 4}).Validate(), ErrorCodeYEAR))
+	assert.True(t, errors.Is((&TOUParams{TimeZones: 2, DailyTables: 2, Periods: 15, Tariffs: 4}).Validate(), ErrorCodeDAY))
+	assert.True(t, errors.Is((&TOUParams{TimeZones: 2, DailyTables: 2, Periods: 8, Tariffs: 64}).Validate(), ErrorCodeRATE))
+
+	assert.NoError(t, validateTimeZones([]TimeZone{{Month: 1, Day: 1, DailyTable: 1}, {Month: 7, Day: 1, DailyTable: 2}}))
+	assert.True(t, errors.Is(validateTimeZones(make([]TimeZone, MaxTimeZones+1)), ErrorCodeYEAR))
+
+	assert.NoError(t, validateTimePeriods([]TimePeriod{{Hour: 0, Minute: 0, Tariff: 3}, {Hour: 8, Minute: 30, Tariff: 1}}))
+	assert.True(t, errors.Is(validateTimePeriods(make([]TimePeriod, MaxPeriods+1)), ErrorCodeDAY))
+	assert.True(t, errors.Is(validateTimePeriods([]TimePeriod{{Hour: 8, Tariff: 64}}), ErrorCodeRATE))
+
+	// 本地校验的错误不是电表的异常应答
+	var frameErr *FrameError
+	assert.False(t, errors.As((&TOUParams{TimeZones: 15, DailyTables: 2, Periods: 8, Tariffs: 4}).Validate(), &frameErr))
+}
@@ -1,7 +1,9 @@
 package dlt645
 
 import (
+	"errors"
 	"github.com/expgo/log"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,6 +23,21 @@ State
 */
 type State int
 
+/*
+EchoMode the local echo of the half-duplex adapter, which echo the sent bytes back on the rx
+
+	@EnumConfig(marshal, noCase)
+	@Enum {
+		Off  // 不回显
+		On   // 回显发送的数据, 读取并校验回显后再解析应答
+		Auto // 第一次请求时自动检测是否回显
+	}
+*/
+type EchoMode int
+
+// ErrEcho the read back echo is not the sent bytes
+var ErrEcho = errors.New("echo mismatch")
+
 type Transporter interface {
 	Open() error
 	Close() error
@@ -29,6 +46,18 @@ type Transporter interface {
 	State() State
 	setState(state State, err error)
 	SetStateChangeCallback(callback func(oldState, newState State))
+	setReadTimeout(timeout time.Duration)
+	getReadTimeout() time.Duration
+	abortRead()
+	resetAbort()
+	getBus() *bus
+	getTiming() Timing
+	getEchoMode() EchoMode
+}
+
+// readDeadliner the connection whose blocking read is aborted by setting the read deadline
+type readDeadliner interface {
+	SetReadDeadline(t time.Time) error
 }
 
 type baseTransporter struct {
@@ -36,7 +65,13 @@ type baseTransporter struct {
 	ReadTimeout          time.Duration `value:"3s"`
 	WriteTimeout         time.Duration `value:"3s"`
 	ReconnectionInterval time.Duration `value:"10s"`
+	Timing               Timing        // 总线时序, 串口按波特率计算缺省值
+	EchoMode             EchoMode      // 半双工适配器的回显, 串口缺省自动检测
 	addr                 string
+	nextReadTimeout      time.Duration // 客户端设置的下一次读的超时
+	aborted              atomic.Bool   // 当前请求的读已中断, 请求开始时清除
+	abortConn            readDeadliner // 中断读时设置截止时间的连接
+	abortLock            sync.Mutex
 
 	reconnectTimer *time.Timer
 	state          State       `value:"unknown"`
@@ -44,12 +79,87 @@ type baseTransporter struct {
 	callback       func(oldState, newState State)
 	stateLock      sync.Mutex
 	running        atomic.Bool
+	bus            bus
 }
 
 func (t *baseTransporter) State() State {
 	return t.state
 }
 
+// setReadTimeout set the timeout of the next read, the ReadTimeout is not changed
+func (t *baseTransporter) setReadTimeout(timeout time.Duration) {
+	t.nextReadTimeout = timeout
+}
+
+// getReadTimeout return the configured ReadTimeout
+func (t *baseTransporter) getReadTimeout() time.Duration {
+	return t.ReadTimeout
+}
+
+// readTimeout return the timeout of the read, which is set by setReadTimeout and no longer than the ReadTimeout
+func (t *baseTransporter) readTimeout() time.Duration {
+	if t.nextReadTimeout > 0 && (t.nextReadTimeout < t.ReadTimeout || t.ReadTimeout <= 0) {
+		return t.nextReadTimeout
+	}
+	return t.ReadTimeout
+}
+
+// getBus return the bus of the transporter, which serialize the transactions of all the clients
+func (t *baseTransporter) getBus() *bus {
+	return &t.bus
+}
+
+// getTiming return the bus timing of the transporter
+func (t *baseTransporter) getTiming() Timing {
+	return t.Timing
+}
+
+// getEchoMode return the local echo mode of the transporter
+func (t *baseTransporter) getEchoMode() EchoMode {
+	return t.EchoMode
+}
+
+// abortRead abort the blocking read of the current request, and the following reads of the request return the
+// timeout error until resetAbort, so the abort before the read is not lost
+func (t *baseTransporter) abortRead() {
+	t.aborted.Store(true)
+
+	t.abortLock.Lock()
+	defer t.abortLock.Unlock()
+	if t.abortConn != nil {
+		_ = t.abortConn.SetReadDeadline(time.Now())
+	}
+}
+
+// resetAbort clear the abort of the last request, called when the request starts
+func (t *baseTransporter) resetAbort() {
+	t.aborted.Store(false)
+}
+
+// setAbortConn set the connection whose blocking read is aborted by abortRead, nil after the connection is closed
+func (t *baseTransporter) setAbortConn(conn readDeadliner) {
+	t.abortLock.Lock()
+	defer t.abortLock.Unlock()
+	t.abortConn = conn
+}
+
+// armReadDeadline set the read deadline of the conn by the read timeout, the timeout error is returned if the read is
+// aborted, the abort may set the deadline before the read overwrite it
+func (t *baseTransporter) armReadDeadline(conn readDeadliner) error {
+	if err := conn.SetReadDeadline(time.Now().Add(t.readTimeout())); err != nil {
+		return err
+	}
+	return t.checkAbort()
+}
+
+// checkAbort return the timeout error if the read is aborted, used by the transporter reading the in-memory stream
+func (t *baseTransporter) checkAbort() error {
+	if t.aborted.Load() {
+		return os.ErrDeadlineExceeded
+	}
+	return nil
+}
+
 func (t *baseTransporter) SetStateChangeCallback(callback func(oldState, newState State)) {
 	t.callback = callback
 }
@@ -0,0 +1,428 @@
+package dlt645
+
+import (
+	"errors"
+	"github.com/expgo/factory"
+	"github.com/expgo/log"
+	"net"
+	"regexp"
+	"sync"
+	"time"
+)
+
+/*
+DtuEvent the event of the dtu connected to the DtuServer
+
+	@EnumConfig(marshal, noCase)
+	@Enum {
+		Connect    // dtu注册成功
+		Disconnect // dtu断开连接
+	}
+*/
+type DtuEvent int
+
+// DtuPattern the registration and heartbeat packet of the dtu, the packet is matched as bytes
+type DtuPattern struct {
+	// 注册包, 第一个子匹配为dtu的id, 没有子匹配时整个匹配为id. 注册包可能分多次收到, 模式需要能确定注册包的结束,
+	// 如 `^REG:(\w+);`, 注册包后的数据作为电表的应答数据
+	Register *regexp.Regexp
+	// 心跳包, 只在帧与帧之间匹配并过滤掉, 为nil时不过滤
+	Heartbeat *regexp.Regexp
+}
+
+// register return the dtu id and the end of the registration packet in the data, false if the packet is not matched
+func (p *DtuPattern) register(data []byte) (string, int, bool) {
+	m := p.Register.FindSubmatchIndex(data)
+	if m == nil {
+		return "", 0, false
+	}
+	if len(m) > 2 && m[2] >= 0 {
+		return string(data[m[2]:m[3]]), m[1], m[3] > m[2]
+	}
+	return string(data[m[0]:m[1]]), m[1], m[1] > m[0]
+}
+
+// frameStart return the start of the first frame in the data and the size of the frame with the preamble bytes,
+// the size is -1 if the header of the frame is not received completely, the start is -1 if there is no frame
+func frameStart(data []byte) (int, int) {
+	for i := 0; i < len(data); i++ {
+		if data[i] != PRE_BYTE && data[i] != FrameStartByte {
+			continue
+		}
+
+		j := i
+		for j < len(data) && data[j] == PRE_BYTE {
+			j++
+		}
+		switch {
+		case j == len(data):
+			return i, -1
+		case data[j] != FrameStartByte:
+			i = j - 1
+		case len(data)-j < 8:
+			return i, -1
+		case data[j+7] != FrameStartByte:
+			i = j
+		case len(data)-j < FRAME_HEADER_LEN:
+			return i, -1
+		default:
+			return i, j - i + FRAME_HEADER_LEN + int(data[j+FRAME_HEADER_LEN-1]) + 2
+		}
+	}
+	return -1, 0
+}
+
+// heartbeatFilter remove the heartbeat packets from the data of the dtu. the heartbeat is only matched at the
+// boundary of the frames, the frame is passed through even if it contains the heartbeat, and the data which may be
+// the partial heartbeat is buffered until it is complete
+type heartbeatFilter struct {
+	pattern *regexp.Regexp
+	pending []byte
+	remain  int // 当前帧还未收到的字节数
+}
+
+// filter return the data without the heartbeat
+func (f *heartbeatFilter) filter(data []byte) (out []byte) {
+	if f.pattern == nil {
+		return data
+	}
+
+	f.pending = append(f.pending, data...)
+	for len(f.pending) > 0 {
+		if f.remain > 0 {
+			n := f.remain
+			if n > len(f.pending) {
+				n = len(f.pending)
+			}
+			out = append(out, f.pending[:n]...)
+			f.pending = f.pending[n:]
+			f.remain -= n
+			continue
+		}
+
+		if loc := f.pattern.FindIndex(f.pending); loc != nil && loc[0] == 0 && loc[1] > 0 {
+			f.pending = f.pending[loc[1]:]
+			continue
+		}
+
+		start, size := frameStart(f.pending)
+		switch {
+		case start < 0:
+			// 可能是不完整的心跳包, 超过最大长度时作为数据
+			if len(f.pending) >= MaxDatagramSize {
+				out = append(out, f.pending...)
+				f.pending = nil
+			}
+			return out
+		case start > 0:
+			// 帧前面的数据不是心跳包
+			out = append(out, f.pending[:start]...)
+			f.pending = f.pending[start:]
+		case size < 0:
+			return out
+		default:
+			f.remain = size
+		}
+	}
+
+	return out
+}
+
+// DtuServer accept the connection of the dtu which dial in, identify the dtu by the registration packet,
+// and give out one DtuTransporter per registered dtu
+type DtuServer struct {
+	log.InnerLog
+	RegisterTimeout  time.Duration `value:"10s"` // 连接后等待注册包的超时
+	HeartbeatTimeout time.Duration `value:"5m"`  // 没有收到任何数据的超时, 超时后断开连接
+	addr             string
+	pattern          DtuPattern
+	listener         net.Listener
+	transporters     map[string]*DtuTransporter
+	callback         func(event DtuEvent, id string, t *DtuTransporter)
+	lock             sync.Mutex
+}
+
+func NewDtuServer(addr string, pattern DtuPattern) *DtuServer {
+	return factory.NewBeforeInit[DtuServer](func(ret *DtuServer) {
+		ret.addr = addr
+		ret.pattern = pattern
+		ret.transporters = map[string]*DtuTransporter{}
+	})
+}
+
+// SetEventCallback set the callback of the connect and disconnect event of the dtu
+func (s *DtuServer) SetEventCallback(callback func(event DtuEvent, id string, t *DtuTransporter)) {
+	s.callback = callback
+}
+
+// Start listen the address and accept the connection of the dtu
+func (s *DtuServer) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	go s.accept(listener)
+
+	return nil
+}
+
+// Addr return the listen address of the server
+func (s *DtuServer) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Close stop the listener and close all the dtu transporters
+func (s *DtuServer) Close() error {
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+
+	s.lock.Lock()
+	transporters := make([]*DtuTransporter, 0, len(s.transporters))
+	for _, t := range s.transporters {
+		transporters = append(transporters, t)
+	}
+	s.lock.Unlock()
+
+	for _, t := range transporters {
+		_ = t.Close()
+	}
+
+	return err
+}
+
+// Transporter return the transporter of the registered dtu
+func (s *DtuServer) Transporter(id string) (*DtuTransporter, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	t, ok := s.transporters[id]
+	return t, ok
+}
+
+func (s *DtuServer) accept(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				s.L.Warnf("dtu server %s accept failed: %v", s.addr, err)
+			}
+			return
+		}
+
+		go s.serve(conn)
+	}
+}
+
+func (s *DtuServer) emit(event DtuEvent, id string, t *DtuTransporter) {
+	if s.callback != nil {
+		s.callback(event, id, t)
+	}
+}
+
+func (s *DtuServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	// 注册包可能分多次收到, 也可能和之后的数据一起收到
+	buf := make([]byte, MaxDatagramSize)
+	var packet []byte
+	var id string
+	_ = conn.SetReadDeadline(time.Now().Add(s.RegisterTimeout))
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			s.L.Warnf("dtu %s register failed: %v, received: % X", conn.RemoteAddr(), err, packet)
+			return
+		}
+
+		packet = append(packet, buf[:n]...)
+		var end int
+		var ok bool
+		if id, end, ok = s.pattern.register(packet); ok {
+			packet = packet[end:]
+			break
+		}
+
+		if len(packet) >= MaxDatagramSize {
+			s.L.Warnf("dtu %s register failed, unknown packet: % X", conn.RemoteAddr(), packet)
+			return
+		}
+	}
+
+	s.lock.Lock()
+	t, ok := s.transporters[id]
+	if !ok {
+		t = newDtuTransporter(s, id)
+		s.transporters[id] = t
+	}
+	s.lock.Unlock()
+
+	t.attach(conn)
+	s.emit(DtuEventConnect, id, t)
+
+	filter := &heartbeatFilter{pattern: s.pattern.Heartbeat}
+	t.feed(filter.filter(packet))
+
+	var err error
+	for {
+		if s.HeartbeatTimeout > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(s.HeartbeatTimeout))
+		} else {
+			_ = conn.SetReadDeadline(time.Time{})
+		}
+
+		var n int
+		if n, err = conn.Read(buf); err != nil {
+			break
+		}
+		t.feed(filter.filter(buf[:n]))
+	}
+
+	if t.detach(conn, err) {
+		s.emit(DtuEventDisconnect, id, t)
+	}
+}
+
+// remove the closed transporter, the next registration of the dtu create a new transporter
+func (s *DtuServer) remove(t *DtuTransporter) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.transporters[t.id] == t {
+		delete(s.transporters, t.id)
+	}
+}
+
+// DtuTransporter the transporter of the dtu connected to the DtuServer, the heartbeat is filtered out of the data.
+// the transporter is disconnected when the dtu is offline, and connected again when the dtu registered again
+type DtuTransporter struct {
+	baseTransporter
+	id     string
+	server *DtuServer
+	conn   net.Conn
+	lock   sync.Mutex
+	stream *stream
+}
+
+func newDtuTransporter(server *DtuServer, id string) *DtuTransporter {
+	return factory.NewBeforeInit[DtuTransporter](func(ret *DtuTransporter) {
+		ret.baseTransporter.addr = id
+		ret.ReconnectionInterval = 0 // dtu主动连接, 不需要重连
+		ret.id = id
+		ret.server = server
+		ret.stream = newStream()
+		ret.running.Store(true)
+	})
+}
+
+// ID return the id of the dtu
+func (t *DtuTransporter) ID() string {
+	return t.id
+}
+
+func (t *DtuTransporter) attach(conn net.Conn) {
+	t.lock.Lock()
+	old := t.conn
+	t.conn = conn
+	t.lock.Unlock()
+	t.stream.reset()
+
+	if old != nil {
+		_ = old.Close()
+	}
+
+	t.setState(StateConnected, nil)
+	t.stream.wake()
+}
+
+// detach return false if the conn is replaced by the new connection of the dtu
+func (t *DtuTransporter) detach(conn net.Conn, err error) bool {
+	t.lock.Lock()
+	if t.conn != conn {
+		t.lock.Unlock()
+		return false
+	}
+	t.conn = nil
+	t.lock.Unlock()
+
+	t.setState(StateDisconnected, err)
+	t.stream.wake()
+	return true
+}
+
+func (t *DtuTransporter) feed(data []byte) {
+	t.stream.write(data)
+}
+
+// Open the dtu is connected by the DtuServer, the state is connected after the dtu registered
+func (t *DtuTransporter) Open() error {
+	t.running.Store(true)
+	return nil
+}
+
+// Close close the connection of the dtu, and remove the transporter from the DtuServer
+func (t *DtuTransporter) Close() error {
+	t.lock.Lock()
+	conn := t.conn
+	t.conn = nil
+	t.lock.Unlock()
+
+	t.setState(StateConnectClosed, nil)
+	_ = t.baseTransporter.Close()
+	t.server.remove(t)
+
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+func (t *DtuTransporter) Write(data []byte) (n int, err error) {
+	t.lock.Lock()
+	conn := t.conn
+	t.lock.Unlock()
+
+	if conn == nil {
+		return 0, errors.New("dtu transporter not connected")
+	}
+
+	err = conn.SetWriteDeadline(time.Now().Add(t.WriteTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err = conn.Write(data)
+	if err != nil {
+		// 关闭连接, 由DtuServer断开
+		_ = conn.Close()
+	}
+	return n, err
+}
+
+// abortRead abort the blocking read of the current request, the read return the timeout error
+func (t *DtuTransporter) abortRead() {
+	t.baseTransporter.abortRead()
+	t.stream.wake()
+}
+
+func (t *DtuTransporter) Read(buf []byte) (int, error) {
+	return t.stream.read(buf, t.readTimeout(), func() error {
+		if err := t.checkAbort(); err != nil {
+			return err
+		}
+
+		t.lock.Lock()
+		defer t.lock.Unlock()
+
+		if t.conn == nil {
+			return errors.New("dtu transporter not connected")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package dlt645
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDtuServer(t *testing.T) {
+	server := NewDtuServer("127.0.0.1:0", DtuPattern{
+		Register:  regexp.MustCompile(`^REG:(\w+);`),
+		Heartbeat: regexp.MustCompile(`HB`),
+	})
+
+	type event struct {
+		event DtuEvent
+		id    string
+	}
+	events := make(chan event, 10)
+	server.SetEventCallback(func(e DtuEvent, id string, t *DtuTransporter) {
+		events <- event{e, id}
+	})
+
+	server.RegisterTimeout = 200 * time.Millisecond
+	assert.NoError(t, server.Start())
+	defer server.Close()
+
+	// 模拟dtu: 注册包分两次发送, 注册包后紧跟心跳包; 应答电表数据, 帧与帧之间夹杂分多次收到的心跳包
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", server.Addr().String())
+		assert.NoError(t, err)
+		_, err = conn.Write([]byte("REG:"))
+		assert.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		_, err = conn.Write([]byte("dtu1;HB"))
+		assert.NoError(t, err)
+		go func() {
+			req := make([]byte, 64)
+			for {
+				n, err := conn.Read(req)
+				if err != nil {
+					return
+				}
+				f, err := NewFrameByRespHeader(bytes.TrimLeft(req[:n], string([]byte{PRE_BYTE}))[:FRAME_HEADER_LEN])
+				if err != nil {
+					return
+				}
+				resp := testFrame(f.GetAddress(), 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 2)
+				_, _ = conn.Write(append([]byte("HB"), resp[:5]...))
+				time.Sleep(5 * time.Millisecond)
+				_, _ = conn.Write(append(resp[5:], 'H'))
+				time.Sleep(5 * time.Millisecond)
+				_, _ = conn.Write([]byte("B"))
+			}
+		}()
+		return conn
+	}
+
+	conn := dial()
+	assert.Equal(t, event{DtuEventConnect, "dtu1"}, <-events)
+
+	tr, ok := server.Transporter("dtu1")
+	assert.True(t, ok)
+	assert.Equal(t, "dtu1", tr.ID())
+	assert.Equal(t, StateConnected, tr.State())
+
+	c := NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	// dtu离线后重新注册, 使用同一个transporter
+	_ = conn.Close()
+	assert.Equal(t, event{DtuEventDisconnect, "dtu1"}, <-events)
+	assert.Equal(t, StateDisconnected, tr.State())
+
+	conn = dial()
+	defer conn.Close()
+	assert.Equal(t, event{DtuEventConnect, "dtu1"}, <-events)
+	again, _ := server.Transporter("dtu1")
+	assert.Same(t, tr, again)
+
+	v = c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+
+	// 帧中与心跳包相同的数据不被过滤, 地址4248为 48 42 ("HB")
+	v = c.Read("4248", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	// 没有注册的连接被断开
+	unknown, err := net.Dial("tcp", server.Addr().String())
+	assert.NoError(t, err)
+	defer unknown.Close()
+	_, _ = unknown.Write([]byte("hello"))
+	_ = unknown.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = unknown.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Len(t, events, 0)
+
+	// 关闭后从服务中移除
+	assert.NoError(t, tr.Close())
+	_, ok = server.Transporter("dtu1")
+	assert.False(t, ok)
+}
+
+func TestDtu_HeartbeatFilter(t *testing.T) {
+	resp := testVoltageResponse()
+	f := &heartbeatFilter{pattern: regexp.MustCompile(`HB`)}
+
+	// 不完整的心跳包被缓存
+	assert.Empty(t, f.filter([]byte("H")))
+	// 帧头完整后才能确定帧的长度, 帧中的数据直接通过
+	assert.Empty(t, f.filter(append([]byte("B"), resp[:6]...)))
+	assert.Equal(t, resp[:12], f.filter(resp[6:12]))
+	assert.Equal(t, resp[12:], f.filter(append(append([]byte{}, resp[12:]...), "HBH"...)))
+	assert.Equal(t, resp, f.filter(append([]byte("B"), resp...)))
+
+	// 帧前面不是心跳包的数据不被过滤
+	assert.Equal(t, append([]byte("XY"), resp...), f.filter(append([]byte("XY"), resp...)))
+
+	// 没有心跳包模式时不过滤
+	assert.Equal(t, []byte("HB"), (&heartbeatFilter{}).filter([]byte("HB")))
+}
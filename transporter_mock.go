@@ -0,0 +1,149 @@
+package dlt645
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/expgo/factory"
+	"sync"
+)
+
+type mockExpectation struct {
+	request   []byte
+	responses [][]byte
+}
+
+// MockTransporter the in-memory transporter for the test without the meter. the written request is checked with the
+// expectations in order, and the responses of the matched expectation are read back. the leading 0xFE preamble bytes
+// are ignored when matching the request
+type MockTransporter struct {
+	baseTransporter
+	expectations []*mockExpectation
+	handler      func(req []byte) [][]byte
+	errs         []error
+	lock         sync.Mutex
+	stream       *stream
+}
+
+func NewMockTransporter() *MockTransporter {
+	return factory.NewBeforeInit[MockTransporter](func(ret *MockTransporter) {
+		ret.baseTransporter.addr = "mock"
+		ret.ReconnectionInterval = 0
+		ret.stream = newStream()
+	})
+}
+
+// Expect add the expectation of the request, the responses are replied when the request is written,
+// no response is the meter timeout
+func (t *MockTransporter) Expect(request []byte, responses ...[]byte) *MockTransporter {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.expectations = append(t.expectations, &mockExpectation{request: bytes.TrimLeft(request, string([]byte{PRE_BYTE})), responses: responses})
+	return t
+}
+
+// ExpectRead add the expectation of reading the dic of the meter, the response is the dic code and the data,
+// the data is in the order of the transmission without the 0x33 mask
+func (t *MockTransporter) ExpectRead(addr string, dic DIC, protocol P, data []byte) *MockTransporter {
+	req, err := NewReadFrame(addr, dic, protocol)
+	if err != nil {
+		t.lock.Lock()
+		t.errs = append(t.errs, err)
+		t.lock.Unlock()
+		return t
+	}
+
+	return t.Expect(req.Bytes(), MockResponse(req, append(dic.Code(protocol), data...)))
+}
+
+// Handle set the handler of the request which has no expectation, such as the simulator of the meter
+func (t *MockTransporter) Handle(handler func(req []byte) [][]byte) *MockTransporter {
+	t.handler = handler
+	return t
+}
+
+// Verify return the error of the unexpected request and the expectation not met
+func (t *MockTransporter) Verify() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	errs := append([]error{}, t.errs...)
+	for _, e := range t.expectations {
+		errs = append(errs, fmt.Errorf("expected request not written: % X", e.request))
+	}
+	return errors.Join(errs...)
+}
+
+// MockResponse return the bytes of the normal response frame of the request, the data is without the 0x33 mask
+func MockResponse(req *Frame, data []byte) []byte {
+	resp := &Frame{Start: FrameStartByte, Address: req.Address, AddrEnd: FrameStartByte, C: req.C | 0x80, End: FrameEndByte}
+	resp.Data = append([]byte{}, data...)
+	resp.L = byte(len(resp.Data))
+	resp.DataAddMask()
+	resp.CalcCS()
+
+	return resp.Bytes()
+}
+
+func (t *MockTransporter) Open() error {
+	if !t.running.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	t.setState(StateConnected, nil)
+	return nil
+}
+
+func (t *MockTransporter) Close() error {
+	t.setState(StateConnectClosed, nil)
+	return t.baseTransporter.Close()
+}
+
+func (t *MockTransporter) Write(data []byte) (int, error) {
+	if t.state != StateConnected {
+		return 0, errors.New("mock transporter not connected")
+	}
+
+	req := bytes.TrimLeft(data, string([]byte{PRE_BYTE}))
+
+	t.lock.Lock()
+	var responses [][]byte
+	if len(t.expectations) > 0 && bytes.Equal(t.expectations[0].request, req) {
+		responses = t.expectations[0].responses
+		t.expectations = t.expectations[1:]
+		t.lock.Unlock()
+	} else if t.handler != nil {
+		t.lock.Unlock()
+		responses = t.handler(append([]byte{}, data...))
+	} else {
+		err := fmt.Errorf("unexpected request: % X", data)
+		t.errs = append(t.errs, err)
+		t.lock.Unlock()
+		return 0, err
+	}
+
+	for _, resp := range responses {
+		t.stream.write(resp)
+	}
+	return len(data), nil
+}
+
+// Inject write the data to the read stream without the request, such as the late response or the noise of the bus
+func (t *MockTransporter) Inject(data []byte) {
+	t.stream.write(data)
+}
+
+// abortRead abort the blocking read of the current request, the read return the timeout error
+func (t *MockTransporter) abortRead() {
+	t.baseTransporter.abortRead()
+	t.stream.wake()
+}
+
+func (t *MockTransporter) Read(buf []byte) (int, error) {
+	if t.state != StateConnected {
+		return 0, errors.New("mock transporter not connected")
+	}
+
+	return t.stream.read(buf, t.readTimeout(), t.checkAbort)
+}
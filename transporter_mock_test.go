@@ -0,0 +1,102 @@
+package dlt645
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testFrame return the frame bytes of the meter with the preamble bytes, the data is without the 0x33 mask
+func testFrame(addr string, c Code, data []byte, preambles int) []byte {
+	f := &Frame{Start: FrameStartByte, AddrEnd: FrameStartByte, C: c, End: FrameEndByte}
+	_ = f.SetAddress(addr, false)
+	f.Data = append([]byte{}, data...)
+	f.L = byte(len(f.Data))
+	f.DataAddMask()
+	f.CalcCS()
+
+	return append(bytes.Repeat([]byte{PRE_BYTE}, preambles), f.Bytes()...)
+}
+
+// testVoltageResponse return the response of reading the phase A voltage 230V of the meter 1, with 2 preamble bytes
+func testVoltageResponse() []byte {
+	return testFrame("1", 0x91, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23), 2)
+}
+
+// noResponse the handler of the meter which does not response
+func noResponse([]byte) [][]byte {
+	return nil
+}
+
+// newTestTransporter return the opened mock transporter which answer the requests by the handler
+func newTestTransporter(t *testing.T, handler func(req []byte) [][]byte) *MockTransporter {
+	tr := NewMockTransporter().Handle(handler)
+	assert.NoError(t, tr.Open())
+	t.Cleanup(func() { _ = tr.Close() })
+	return tr
+}
+
+func TestMockTransporter(t *testing.T) {
+	tr := NewMockTransporter()
+
+	var states []State
+	tr.SetStateChangeCallback(func(oldState, newState State) {
+		states = append(states, newState)
+	})
+
+	_, err := tr.Write([]byte{0x01})
+	assert.Error(t, err)
+
+	assert.NoError(t, tr.Open())
+	assert.Equal(t, StateConnected, tr.State())
+
+	// 没有预期的请求由模拟电表处理
+	tr.Handle(func(req []byte) [][]byte {
+		f, err := NewFrameByRespHeader(req[:PRE_BYTE_LEN+FRAME_HEADER_LEN])
+		if err != nil {
+			return nil
+		}
+		return [][]byte{MockResponse(f, append(DICPhaseAVoltage.Code(PV2007), 0x00, 0x23))}
+	})
+
+	c := NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+	assert.NoError(t, tr.Verify())
+
+	// 读的超时不超过配置的ReadTimeout
+	assert.Equal(t, 3*time.Second, tr.ReadTimeout)
+	tr.setReadTimeout(time.Minute)
+	assert.Equal(t, 3*time.Second, tr.readTimeout())
+	tr.setReadTimeout(time.Millisecond)
+	assert.Equal(t, time.Millisecond, tr.readTimeout())
+	assert.Equal(t, 3*time.Second, tr.ReadTimeout)
+
+	// 中断阻塞的读
+	tr.setReadTimeout(time.Minute)
+	time.AfterFunc(20*time.Millisecond, tr.abortRead)
+	_, err = tr.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+
+	// 读之前的中断不会丢失, 直到下一个请求开始
+	tr.resetAbort()
+	tr.abortRead()
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		_, err = tr.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	}
+	tr.resetAbort()
+	tr.Inject([]byte{0x68})
+	n, err := tr.Read(make([]byte, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	assert.NoError(t, tr.Close())
+	assert.Equal(t, []State{StateConnected, StateConnectClosed}, states)
+}
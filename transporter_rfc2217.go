@@ -0,0 +1,354 @@
+package dlt645
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"github.com/expgo/factory"
+	"github.com/tarm/serial"
+	"net"
+	"os"
+	"time"
+)
+
+// telnet commands and options of RFC 854 and RFC 2217
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetOptBinary  = 0
+	telnetOptSGA     = 3
+	telnetOptComPort = 44
+
+	comPortSetBaudRate = 1
+	comPortSetDataSize = 2
+	comPortSetParity   = 3
+	comPortSetStopSize = 4
+	comPortServerBase  = 100 // 服务端应答的命令为客户端命令加100
+)
+
+var comPortParity = map[serial.Parity]byte{
+	serial.ParityNone:  1,
+	serial.ParityOdd:   2,
+	serial.ParityEven:  3,
+	serial.ParityMark:  4,
+	serial.ParitySpace: 5,
+}
+
+var comPortStopSize = map[serial.StopBits]byte{
+	serial.Stop1:     1,
+	serial.Stop2:     2,
+	serial.Stop1Half: 3,
+}
+
+const (
+	telnetStateData = iota
+	telnetStateIAC
+	telnetStateOption
+	telnetStateSB
+	telnetStateSBIAC
+)
+
+// telnetDecoder decode the telnet stream, the command may span the reads
+type telnetDecoder struct {
+	state int
+	cmd   byte
+	sb    []byte
+}
+
+// decode append the data bytes of the in to the out, the option negotiation and the sub negotiation are passed to handle
+func (d *telnetDecoder) decode(in []byte, out []byte, handle func(cmd, opt byte, sb []byte)) []byte {
+	for _, b := range in {
+		switch d.state {
+		case telnetStateData:
+			if b == telnetIAC {
+				d.state = telnetStateIAC
+			} else {
+				out = append(out, b)
+			}
+		case telnetStateIAC:
+			switch b {
+			case telnetIAC:
+				out = append(out, b)
+				d.state = telnetStateData
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				d.cmd = b
+				d.state = telnetStateOption
+			case telnetSB:
+				d.sb = d.sb[:0]
+				d.state = telnetStateSB
+			default:
+				d.state = telnetStateData
+			}
+		case telnetStateOption:
+			handle(d.cmd, b, nil)
+			d.state = telnetStateData
+		case telnetStateSB:
+			if b == telnetIAC {
+				d.state = telnetStateSBIAC
+			} else {
+				d.sb = append(d.sb, b)
+			}
+		case telnetStateSBIAC:
+			switch b {
+			case telnetIAC:
+				d.sb = append(d.sb, b)
+				d.state = telnetStateSB
+			case telnetSE:
+				if len(d.sb) > 0 {
+					handle(telnetSB, d.sb[0], d.sb[1:])
+				}
+				d.state = telnetStateData
+			default:
+				d.state = telnetStateData
+			}
+		}
+	}
+
+	return out
+}
+
+// telnetEscape double the IAC bytes of the data
+func telnetEscape(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{telnetIAC}, []byte{telnetIAC, telnetIAC})
+}
+
+// Rfc2217Transporter the transporter of the serial device server which support the telnet com port control of RFC 2217,
+// the baud rate, parity and stop bits of the remote serial port are set after connected
+type Rfc2217Transporter struct {
+	baseTransporter
+	conf    *serial.Config
+	conn    net.Conn
+	decoder telnetDecoder
+	raw     []byte
+	pending []byte // 已经解码还没有读取的数据
+}
+
+// NewRfc2217Transport create the rfc2217 transporter, the Name of the conf is not used, the zero size,
+// parity and stop bits of the conf is 8E1 of the standard
+func NewRfc2217Transport(addr string, conf *serial.Config) *Rfc2217Transporter {
+	return factory.NewBeforeInit[Rfc2217Transporter](func(ret *Rfc2217Transporter) {
+		c := defaultSerialConfig(conf)
+		ret.baseTransporter.addr = addr
+		ret.conf = &c
+		ret.raw = make([]byte, 1024)
+		ret.Timing = NewTiming(c.Baud)
+	})
+}
+
+func (t *Rfc2217Transporter) Open() (err error) {
+	if !t.running.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	if t.state == StateConnected {
+		return nil
+	}
+
+	t.setState(StateConnecting, nil)
+	dialer := net.Dialer{Timeout: 3 * time.Second}
+	t.conn, err = dialer.Dial("tcp", t.addr)
+	if err == nil {
+		t.decoder = telnetDecoder{}
+		t.pending = nil
+		err = t.negotiate()
+	}
+	if err != nil {
+		t.L.Warnf("Open rfc2217 %s failed: %v", t.addr, err)
+		if t.conn != nil {
+			_ = t.conn.Close()
+			t.conn = nil
+		}
+		t.setState(StateDisconnected, err)
+		return err
+	}
+
+	t.setAbortConn(t.conn)
+	t.setState(StateConnected, nil)
+
+	return err
+}
+
+// negotiate the binary transmission and the com port control, then set the serial port of the conf
+func (t *Rfc2217Transporter) negotiate() error {
+	cmds := []byte{
+		telnetIAC, telnetWILL, telnetOptBinary,
+		telnetIAC, telnetDO, telnetOptBinary,
+		telnetIAC, telnetWILL, telnetOptComPort,
+	}
+	if _, err := t.writeRaw(cmds); err != nil {
+		return err
+	}
+
+	return t.setSerial(t.conf)
+}
+
+// SetSerial set the baud rate, data size, parity and stop bits of the remote serial port, the zero size, parity and
+// stop bits are 8E1. The bus is acquired first, so the setting does not interleave with the request of the clients.
+func (t *Rfc2217Transporter) SetSerial(conf *serial.Config) error {
+	b := t.getBus()
+	if err := b.acquire(context.Background(), PriorityControl); err != nil {
+		return err
+	}
+	defer b.release()
+
+	c := defaultSerialConfig(conf)
+	return t.setSerial(&c)
+}
+
+// setSerial set the remote serial port without acquiring the bus
+func (t *Rfc2217Transporter) setSerial(conf *serial.Config) error {
+	parity, ok := comPortParity[conf.Parity]
+	if !ok {
+		return serial.ErrBadParity
+	}
+	stopSize, ok := comPortStopSize[conf.StopBits]
+	if !ok {
+		return serial.ErrBadStopBits
+	}
+	if conf.Size < 5 || conf.Size > 8 {
+		return serial.ErrBadSize
+	}
+
+	var baud [4]byte
+	binary.BigEndian.PutUint32(baud[:], uint32(conf.Baud))
+
+	var buf bytes.Buffer
+	for _, sb := range [][]byte{
+		append([]byte{comPortSetBaudRate}, baud[:]...),
+		{comPortSetDataSize, conf.Size},
+		{comPortSetParity, parity},
+		{comPortSetStopSize, stopSize},
+	} {
+		buf.Write([]byte{telnetIAC, telnetSB, telnetOptComPort})
+		buf.Write(telnetEscape(sb))
+		buf.Write([]byte{telnetIAC, telnetSE})
+	}
+
+	if _, err := t.writeRaw(buf.Bytes()); err != nil {
+		return err
+	}
+
+	t.conf = conf
+	t.Timing = NewTiming(conf.Baud)
+	return nil
+}
+
+func (t *Rfc2217Transporter) Close() (err error) {
+	defer func() {
+		t.setState(StateConnectClosed, err)
+		t.conn = nil
+	}()
+
+	_ = t.baseTransporter.Close()
+	t.setAbortConn(nil)
+
+	if t.conn == nil {
+		return nil
+	}
+
+	return t.conn.Close()
+}
+
+func (t *Rfc2217Transporter) writeRaw(data []byte) (int, error) {
+	if t.conn == nil {
+		return 0, errors.New("rfc2217 transporter not connected")
+	}
+
+	err := t.conn.SetWriteDeadline(time.Now().Add(t.WriteTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	return t.conn.Write(data)
+}
+
+func (t *Rfc2217Transporter) Write(data []byte) (n int, err error) {
+	if t.conn == nil || t.state == StateDisconnected {
+		return 0, errors.New("rfc2217 transporter not connected")
+	}
+
+	defer func() {
+		if err != nil {
+			t.setState(StateDisconnected, err)
+		}
+	}()
+
+	if _, err = t.writeRaw(telnetEscape(data)); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// handle answer the option negotiation of the server, only the binary and the com port option are accepted
+func (t *Rfc2217Transporter) handle(cmd, opt byte, sb []byte) {
+	var reply byte
+	switch cmd {
+	case telnetDO:
+		if opt == telnetOptBinary || opt == telnetOptComPort {
+			return
+		}
+		reply = telnetWONT
+	case telnetWILL:
+		if opt == telnetOptBinary || opt == telnetOptSGA {
+			return
+		}
+		reply = telnetDONT
+	case telnetSB:
+		if opt == telnetOptComPort && len(sb) > 0 {
+			t.L.Debugf("rfc2217 %s com port response %d: % X", t.addr, int(sb[0])-comPortServerBase, sb[1:])
+		}
+		return
+	default:
+		return
+	}
+
+	if _, err := t.writeRaw([]byte{telnetIAC, reply, opt}); err != nil {
+		t.L.Warnf("rfc2217 %s reply option %d failed: %v", t.addr, opt, err)
+	}
+}
+
+func (t *Rfc2217Transporter) Read(buf []byte) (n int, err error) {
+	if len(t.pending) > 0 {
+		n = copy(buf, t.pending)
+		t.pending = t.pending[n:]
+		return n, nil
+	}
+
+	if t.conn == nil || t.state == StateDisconnected {
+		return 0, errors.New("rfc2217 transporter not connected")
+	}
+
+	defer func() {
+		// 读超时是电表没有应答, 连接仍然可用
+		if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.setState(StateDisconnected, err)
+		}
+	}()
+
+	if err = t.armReadDeadline(t.conn); err != nil {
+		return 0, err
+	}
+
+	// 只有telnet命令时继续读取
+	for {
+		m, err := t.conn.Read(t.raw)
+		if err != nil {
+			return 0, err
+		}
+
+		data := t.decoder.decode(t.raw[:m], nil, t.handle)
+		if len(data) > 0 {
+			n = copy(buf, data)
+			t.pending = data[n:]
+			return n, nil
+		}
+	}
+}
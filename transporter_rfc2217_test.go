@@ -0,0 +1,142 @@
+package dlt645
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tarm/serial"
+)
+
+// rfc2217Server the stand-in serial device server, which answer the read request of the meter, and echo the other data
+type rfc2217Server struct {
+	listener net.Listener
+	lock     sync.Mutex
+	settings map[byte][]byte
+	replies  [][]byte
+}
+
+func newRfc2217Server(t *testing.T, resp []byte) *rfc2217Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	s := &rfc2217Server{listener: listener, settings: map[byte][]byte{}}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// 服务端要求客户端不支持的终端类型选项
+		_, _ = conn.Write([]byte{telnetIAC, telnetWILL, telnetOptSGA, telnetIAC, telnetDO, 24})
+
+		var d telnetDecoder
+		var data []byte
+		raw := make([]byte, 1024)
+		for {
+			n, err := conn.Read(raw)
+			if err != nil {
+				return
+			}
+
+			data = d.decode(raw[:n], data, func(cmd, opt byte, sb []byte) {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				switch cmd {
+				case telnetSB:
+					s.settings[sb[0]] = append([]byte{}, sb[1:]...)
+					ack := append([]byte{telnetIAC, telnetSB, opt, sb[0] + comPortServerBase}, telnetEscape(sb[1:])...)
+					_, _ = conn.Write(append(ack, telnetIAC, telnetSE))
+				case telnetWONT, telnetDONT:
+					s.replies = append(s.replies, []byte{cmd, opt})
+				}
+			})
+
+			switch {
+			case len(data) > 0 && data[0] == PRE_BYTE:
+				if data[len(data)-1] == FrameEndByte {
+					_, _ = conn.Write(telnetEscape(resp))
+					data = nil
+				}
+			case len(data) > 0:
+				_, _ = conn.Write(telnetEscape(data))
+				data = nil
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *rfc2217Server) setting(cmd byte) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.settings[cmd]
+}
+
+func TestRfc2217Transporter(t *testing.T) {
+	resp := testVoltageResponse()
+
+	server := newRfc2217Server(t, resp)
+	defer server.listener.Close()
+
+	tr := NewRfc2217Transport(server.listener.Addr().String(), &serial.Config{Baud: 2400})
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+
+	c := NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	assert.Equal(t, []byte{0x00, 0x00, 0x09, 0x60}, server.setting(comPortSetBaudRate))
+	assert.Equal(t, []byte{8}, server.setting(comPortSetDataSize))
+	assert.Equal(t, []byte{3}, server.setting(comPortSetParity))
+	assert.Equal(t, []byte{1}, server.setting(comPortSetStopSize))
+
+	assert.Eventually(t, func() bool {
+		server.lock.Lock()
+		defer server.lock.Unlock()
+		return len(server.replies) == 1 && bytes.Equal(server.replies[0], []byte{telnetWONT, 24})
+	}, time.Second, time.Millisecond)
+
+	// 0xFF数据的转义
+	data := []byte{0x01, 0xFF, 0xFF, 0x02}
+	n, err := tr.Write(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), n)
+
+	var got bytes.Buffer
+	buf := make([]byte, 16)
+	for got.Len() < len(data) {
+		n, err = tr.Read(buf)
+		assert.NoError(t, err)
+		got.Write(buf[:n])
+	}
+	assert.Equal(t, data, got.Bytes())
+
+	// 修改波特率, 0xFF的波特率也需要转义, 修改要等待总线上的请求完成
+	assert.NoError(t, tr.getBus().acquire(context.Background(), PriorityNormal))
+	done := make(chan error)
+	go func() {
+		done <- tr.SetSerial(&serial.Config{Baud: 0xFFFF, Size: 8, Parity: serial.ParityNone, StopBits: serial.Stop2})
+	}()
+	select {
+	case <-done:
+		t.Fatal("SetSerial does not wait for the bus")
+	case <-time.After(50 * time.Millisecond):
+	}
+	tr.getBus().release()
+	assert.NoError(t, <-done)
+	assert.Eventually(t, func() bool {
+		return binary.BigEndian.Uint32(server.setting(comPortSetBaudRate)) == 0xFFFF &&
+			bytes.Equal(server.setting(comPortSetParity), []byte{1}) &&
+			bytes.Equal(server.setting(comPortSetStopSize), []byte{2})
+	}, time.Second, time.Millisecond)
+}
@@ -5,18 +5,57 @@ import (
 	"github.com/expgo/factory"
 	"github.com/tarm/serial"
 	"io"
+	"os"
+	"time"
 )
 
+// serialPort the opened serial port, the read is returned with os.ErrDeadlineExceeded after the read deadline
+type serialPort interface {
+	io.ReadWriteCloser
+	SetReadDeadline(t time.Time) error
+}
+
+// RS485Config the rs485 mode of the linux kernel driver, which control the RTS of the transceiver by TIOCSRS485
+type RS485Config struct {
+	Enabled            bool
+	RTSOnSend          bool          // 发送时RTS为高电平
+	RTSAfterSend       bool          // 发送后RTS为高电平
+	RxDuringTx         bool          // 发送时同时接收
+	DelayRTSBeforeSend time.Duration // 发送前RTS的延时, 精度为毫秒
+	DelayRTSAfterSend  time.Duration // 发送后RTS的延时, 精度为毫秒
+}
+
 type SerialTransporter struct {
 	baseTransporter
-	conf *serial.Config
-	port io.ReadWriteCloser
+	RS485 RS485Config // 只支持Linux
+	conf  *serial.Config
+	port  serialPort
+}
+
+// defaultSerialConfig return the copy of the conf, the zero size, parity and stop bits are 8E1 of the standard
+func defaultSerialConfig(conf *serial.Config) serial.Config {
+	c := *conf
+	if c.Size == 0 {
+		c.Size = serial.DefaultSize
+	}
+	if c.Parity == 0 {
+		c.Parity = serial.ParityEven
+	}
+	if c.StopBits == 0 {
+		c.StopBits = serial.Stop1
+	}
+	return c
 }
 
+// NewSerialTransport create the serial transporter, the zero size, parity and stop bits of the conf
+// is 8E1 of the standard
 func NewSerialTransport(conf *serial.Config) *SerialTransporter {
 	return factory.NewBeforeInit[SerialTransporter](func(ret *SerialTransporter) {
+		c := defaultSerialConfig(conf)
 		ret.baseTransporter.addr = conf.Name
-		ret.conf = conf
+		ret.conf = &c
+		ret.Timing = NewTiming(conf.Baud)
+		ret.EchoMode = EchoModeAuto
 	})
 }
 
@@ -31,7 +70,7 @@ func (t *SerialTransporter) Open() (err error) {
 
 	t.setState(StateConnecting, nil)
 
-	port, err := serial.OpenPort(t.conf)
+	port, err := openSerialPort(t.conf, t.RS485)
 	if err != nil {
 		t.L.Warnf("Open serial %s failed: %v", t.conf.Name, err)
 		t.setState(StateDisconnected, err)
@@ -39,6 +78,7 @@ func (t *SerialTransporter) Open() (err error) {
 	}
 
 	t.port = port
+	t.setAbortConn(port)
 	t.setState(StateConnected, nil)
 
 	return err
@@ -51,6 +91,7 @@ func (t *SerialTransporter) Close() (err error) {
 	}()
 
 	_ = t.baseTransporter.Close()
+	t.setAbortConn(nil)
 
 	if t.port == nil {
 		return nil
@@ -79,10 +120,15 @@ func (t *SerialTransporter) Read(buf []byte) (n int, err error) {
 	}
 
 	defer func() {
-		if err != nil {
+		// 读超时是电表没有应答, 串口仍然可用
+		if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
 			t.setState(StateDisconnected, err)
 		}
 	}()
 
+	if err = t.armReadDeadline(t.port); err != nil {
+		return 0, err
+	}
+
 	return t.port.Read(buf)
 }
@@ -0,0 +1,157 @@
+//go:build linux
+
+package dlt645
+
+import (
+	"fmt"
+	"github.com/tarm/serial"
+	"golang.org/x/sys/unix"
+	"os"
+	"unsafe"
+)
+
+var baudRates = map[int]uint32{
+	1200:   unix.B1200,
+	2400:   unix.B2400,
+	4800:   unix.B4800,
+	9600:   unix.B9600,
+	19200:  unix.B19200,
+	38400:  unix.B38400,
+	57600:  unix.B57600,
+	115200: unix.B115200,
+	230400: unix.B230400,
+}
+
+const (
+	serRS485Enabled      = 1 << 0
+	serRS485RTSOnSend    = 1 << 1
+	serRS485RTSAfterSend = 1 << 2
+	serRS485RxDuringTx   = 1 << 4
+)
+
+// serialRS485 the struct serial_rs485 of linux/serial.h
+type serialRS485 struct {
+	Flags              uint32
+	DelayRTSBeforeSend uint32
+	DelayRTSAfterSend  uint32
+	Padding            [5]uint32
+}
+
+// openSerialPort open the tty by the runtime poller, so the read deadline works
+func openSerialPort(conf *serial.Config, rs485 RS485Config) (serialPort, error) {
+	f, err := os.OpenFile(conf.Name, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := f.SyscallConn()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	var confErr error
+	err = rc.Control(func(fd uintptr) {
+		if confErr = setTermios(int(fd), conf); confErr == nil && rs485.Enabled {
+			confErr = setRS485(int(fd), rs485)
+		}
+	})
+	if err == nil {
+		err = confErr
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// setTermios set the tty to the raw mode of the conf, the read return when there is at least one byte
+func setTermios(fd int, conf *serial.Config) error {
+	baud, ok := baudRates[conf.Baud]
+	if !ok {
+		return fmt.Errorf("unsupported baud rate: %d", conf.Baud)
+	}
+
+	t, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return err
+	}
+
+	t.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON | unix.IXOFF | unix.INPCK
+	t.Oflag &^= unix.OPOST
+	t.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	t.Cflag &^= unix.CSIZE | unix.PARENB | unix.PARODD | unix.CMSPAR | unix.CSTOPB | unix.CRTSCTS | unix.CBAUD
+	t.Cflag |= unix.CREAD | unix.CLOCAL | baud
+	t.Ispeed = baud
+	t.Ospeed = baud
+
+	switch conf.Size {
+	case 5:
+		t.Cflag |= unix.CS5
+	case 6:
+		t.Cflag |= unix.CS6
+	case 7:
+		t.Cflag |= unix.CS7
+	case 8:
+		t.Cflag |= unix.CS8
+	default:
+		return serial.ErrBadSize
+	}
+
+	switch conf.Parity {
+	case serial.ParityNone:
+	case serial.ParityEven:
+		t.Cflag |= unix.PARENB
+	case serial.ParityOdd:
+		t.Cflag |= unix.PARENB | unix.PARODD
+	case serial.ParityMark:
+		t.Cflag |= unix.PARENB | unix.PARODD | unix.CMSPAR
+	case serial.ParitySpace:
+		t.Cflag |= unix.PARENB | unix.CMSPAR
+	default:
+		return serial.ErrBadParity
+	}
+	if conf.Parity != serial.ParityNone {
+		t.Iflag |= unix.INPCK
+	}
+
+	switch conf.StopBits {
+	case serial.Stop1:
+	case serial.Stop2:
+		t.Cflag |= unix.CSTOPB
+	default:
+		return serial.ErrBadStopBits
+	}
+
+	t.Cc[unix.VMIN] = 1
+	t.Cc[unix.VTIME] = 0
+
+	return unix.IoctlSetTermios(fd, unix.TCSETS, t)
+}
+
+// setRS485 enable the rs485 mode of the kernel driver, the driver control the RTS when sending
+func setRS485(fd int, conf RS485Config) error {
+	rs := serialRS485{
+		Flags:              serRS485Enabled,
+		DelayRTSBeforeSend: uint32(conf.DelayRTSBeforeSend.Milliseconds()),
+		DelayRTSAfterSend:  uint32(conf.DelayRTSAfterSend.Milliseconds()),
+	}
+	if conf.RTSOnSend {
+		rs.Flags |= serRS485RTSOnSend
+	}
+	if conf.RTSAfterSend {
+		rs.Flags |= serRS485RTSAfterSend
+	}
+	if conf.RxDuringTx {
+		rs.Flags |= serRS485RxDuringTx
+	}
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.TIOCSRS485, uintptr(unsafe.Pointer(&rs)))
+	if errno != 0 {
+		return fmt.Errorf("set rs485 mode: %w", errno)
+	}
+
+	return nil
+}
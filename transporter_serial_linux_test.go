@@ -0,0 +1,93 @@
+//go:build linux
+
+package dlt645
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tarm/serial"
+	"golang.org/x/sys/unix"
+)
+
+// openPty open the pseudo-terminal pair, return the master and the name of the slave
+func openPty(t *testing.T) (*os.File, string) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("open pty failed: %v", err)
+	}
+	t.Cleanup(func() { _ = master.Close() })
+
+	rc, err := master.SyscallConn()
+	assert.NoError(t, err)
+
+	var n int
+	var ptyErr error
+	assert.NoError(t, rc.Control(func(fd uintptr) {
+		if ptyErr = unix.IoctlSetPointerInt(int(fd), unix.TIOCSPTLCK, 0); ptyErr == nil {
+			n, ptyErr = unix.IoctlGetInt(int(fd), unix.TIOCGPTN)
+		}
+	}))
+	if ptyErr != nil {
+		t.Skipf("unlock pty failed: %v", ptyErr)
+	}
+
+	return master, fmt.Sprintf("/dev/pts/%d", n)
+}
+
+func TestSerialTransporter_Pty(t *testing.T) {
+	master, name := openPty(t)
+
+	tr := NewSerialTransport(&serial.Config{Name: name, Baud: 9600})
+	assert.Equal(t, serial.ParityEven, tr.conf.Parity)
+	assert.Equal(t, byte(8), tr.conf.Size)
+	assert.Equal(t, serial.Stop1, tr.conf.StopBits)
+
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+	assert.Equal(t, StateConnected, tr.State())
+
+	// 模拟电表应答
+	go func() {
+		req := make([]byte, 64)
+		n, err := master.Read(req)
+		if err != nil || n == 0 {
+			return
+		}
+		_, _ = master.Write(testVoltageResponse())
+	}()
+
+	c := NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+	assert.False(t, *tr.bus.echo)
+
+	// 没有应答时读超时, 串口仍然可用
+	tr.setReadTimeout(20 * time.Millisecond)
+	start := time.Now()
+	_, err := tr.Read(make([]byte, 1))
+	assert.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, StateConnected, tr.State())
+
+	// 中断阻塞的读
+	tr.setReadTimeout(time.Minute)
+	time.AfterFunc(20*time.Millisecond, tr.abortRead)
+	_, err = tr.Read(make([]byte, 1))
+	assert.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+
+	// pty不支持rs485模式
+	tr2 := NewSerialTransport(&serial.Config{Name: name, Baud: 9600})
+	tr2.RS485 = RS485Config{Enabled: true, RTSOnSend: true}
+	tr2.ReconnectionInterval = 0
+	assert.Error(t, tr2.Open())
+
+	tr3 := NewSerialTransport(&serial.Config{Name: name, Baud: 12345})
+	tr3.ReconnectionInterval = 0
+	assert.Error(t, tr3.Open())
+}
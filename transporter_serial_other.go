@@ -0,0 +1,63 @@
+//go:build !linux
+
+package dlt645
+
+import (
+	"errors"
+	"github.com/tarm/serial"
+	"io"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// serialPollInterval the read timeout of the tarm serial, the read deadline is checked after it
+const serialPollInterval = 100 * time.Millisecond
+
+// tarmPort the tarm serial only support the read timeout of opening, the read return no data after the timeout,
+// so the read is polled by serialPollInterval until the deadline
+type tarmPort struct {
+	*serial.Port
+	deadline atomic.Int64 // 读截止时间的UnixNano, 0为不超时
+}
+
+func (p *tarmPort) SetReadDeadline(t time.Time) error {
+	if t.IsZero() {
+		p.deadline.Store(0)
+	} else {
+		p.deadline.Store(t.UnixNano())
+	}
+	return nil
+}
+
+// Read return os.ErrDeadlineExceeded if no data is read before the deadline
+func (p *tarmPort) Read(buf []byte) (int, error) {
+	for {
+		n, err := p.Port.Read(buf)
+		if n > 0 || err != nil && err != io.EOF {
+			return n, err
+		}
+
+		if deadline := p.deadline.Load(); deadline != 0 && time.Now().UnixNano() >= deadline {
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+func openSerialPort(conf *serial.Config, rs485 RS485Config) (serialPort, error) {
+	if rs485.Enabled {
+		return nil, errors.New("rs485 mode only support linux")
+	}
+
+	c := *conf
+	if c.ReadTimeout <= 0 || c.ReadTimeout > serialPollInterval {
+		c.ReadTimeout = serialPollInterval
+	}
+
+	port, err := serial.OpenPort(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tarmPort{Port: port}, nil
+}
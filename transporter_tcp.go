@@ -1,15 +1,20 @@
 package dlt645
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"github.com/expgo/factory"
 	"net"
+	"os"
 	"time"
 )
 
 type TcpTransporter struct {
 	baseTransporter
-	conn net.Conn
+	conn      net.Conn
+	tlsConfig *tls.Config
 }
 
 func NewTcpTransport(addr string) *TcpTransporter {
@@ -18,6 +23,44 @@ func NewTcpTransport(addr string) *TcpTransporter {
 	})
 }
 
+// NewTlsTransport create the tcp transporter over tls, the certificates, client authentication and server name
+// are set by the config, see NewTlsConfig
+func NewTlsTransport(addr string, config *tls.Config) *TcpTransporter {
+	return factory.NewBeforeInit[TcpTransporter](func(ret *TcpTransporter) {
+		ret.baseTransporter.addr = addr
+		ret.tlsConfig = config
+	})
+}
+
+// NewTlsConfig create the tls config of the ca file to verify the server, and the cert and key files
+// for the client authentication, the empty file is not used
+func NewTlsConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	config := &tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12}
+
+	if len(caFile) > 0 {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if len(certFile) > 0 || len(keyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
 func (t *TcpTransporter) Open() (err error) {
 	if !t.running.CompareAndSwap(false, true) {
 		return nil
@@ -29,13 +72,21 @@ func (t *TcpTransporter) Open() (err error) {
 
 	t.setState(StateConnecting, nil)
 	dialer := net.Dialer{Timeout: 3 * time.Second}
-	t.conn, err = dialer.Dial("tcp", t.addr)
+	if t.tlsConfig != nil {
+		var conn *tls.Conn
+		if conn, err = tls.DialWithDialer(&dialer, "tcp", t.addr, t.tlsConfig); err == nil {
+			t.conn = conn
+		}
+	} else {
+		t.conn, err = dialer.Dial("tcp", t.addr)
+	}
 	if err != nil {
 		t.L.Warnf("DialTCP %s failed: %v", t.addr, err)
 		t.setState(StateDisconnected, err)
 		return err
 	}
 
+	t.setAbortConn(t.conn)
 	t.setState(StateConnected, nil)
 
 	return err
@@ -48,6 +99,7 @@ func (t *TcpTransporter) Close() (err error) {
 	}()
 
 	_ = t.baseTransporter.Close()
+	t.setAbortConn(nil)
 
 	if t.conn == nil {
 		return nil
@@ -81,13 +133,13 @@ func (t *TcpTransporter) Read(buf []byte) (n int, err error) {
 	}
 
 	defer func() {
-		if err != nil {
+		// 读超时是电表没有应答, 连接仍然可用
+		if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
 			t.setState(StateDisconnected, err)
 		}
 	}()
 
-	err = t.conn.SetReadDeadline(time.Now().Add(t.ReadTimeout))
-	if err != nil {
+	if err = t.armReadDeadline(t.conn); err != nil {
 		return 0, err
 	}
 
@@ -0,0 +1,225 @@
+package dlt645
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCA the locally generated ca which sign the server and client certificates
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue return the pem of the certificate and the key signed by the ca
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestTcpTransporter_TLS(t *testing.T) {
+	ca := newTestCA(t, "test ca")
+	serverCert, serverKey := ca.issue(t, "meter.gateway", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, "client", x509.ExtKeyUsageClientAuth)
+
+	cert, err := tls.X509KeyPair(serverCert, serverKey)
+	assert.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	// 需要客户端证书的电表网关
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	resp := testVoltageResponse()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				req := make([]byte, 64)
+				for {
+					if _, err := conn.Read(req); err != nil {
+						return
+					}
+					_, _ = conn.Write(resp)
+				}
+			}(conn)
+		}
+	}()
+
+	dir := t.TempDir()
+	config, err := NewTlsConfig(writeFile(t, dir, "ca.pem", ca.pem), writeFile(t, dir, "client.pem", clientCert),
+		writeFile(t, dir, "client.key", clientKey), "meter.gateway")
+	assert.NoError(t, err)
+
+	tr := NewTlsTransport(listener.Addr().String(), config)
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+
+	c := NewClient(tr)
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+
+	// 读超时后连接仍然可用
+	tr.setReadTimeout(20 * time.Millisecond)
+	_, err = tr.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+	assert.Equal(t, StateConnected, tr.State())
+	v = c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+
+	// 其他ca签发的服务端证书
+	other, err := NewTlsConfig(writeFile(t, dir, "other.pem", newTestCA(t, "other ca").pem), "", "", "meter.gateway")
+	assert.NoError(t, err)
+	tr2 := NewTlsTransport(listener.Addr().String(), other)
+	tr2.ReconnectionInterval = 0
+	assert.Error(t, tr2.Open())
+	assert.Equal(t, StateDisconnected, tr2.State())
+
+	_, err = NewTlsConfig(writeFile(t, dir, "empty.pem", nil), "", "", "")
+	assert.Error(t, err)
+}
+
+func TestTcpTransporter_SlowResponse(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	// 经过4G网络的dtu, 应答超过DefaultResponseTimeout
+	resp := testVoltageResponse()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		req := make([]byte, 64)
+		for {
+			if _, err := conn.Read(req); err != nil {
+				return
+			}
+			time.Sleep(DefaultResponseTimeout*time.Millisecond + 200*time.Millisecond)
+			_, _ = conn.Write(resp)
+		}
+	}()
+
+	tr := NewTcpTransport(listener.Addr().String())
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+
+	// 没有总线时序时使用ReadTimeout等待应答
+	c := NewClient(tr)
+	assert.Equal(t, tr.ReadTimeout, c.(*client).frameTimeout())
+	v := c.Read("1", DICPhaseAVoltage)
+	assert.NoError(t, v[0].Err)
+	assert.Equal(t, "230", v[0].Value.String())
+}
+
+func TestTcpTransporter_AbortRead(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	// 不应答的电表
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	tr := NewTcpTransport(listener.Addr().String())
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+
+	// 请求在读之前取消, 读设置的截止时间不覆盖中断
+	tr.resetAbort()
+	tr.abortRead()
+	tr.setReadTimeout(time.Minute)
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		_, err = tr.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	}
+	assert.Equal(t, StateConnected, tr.State())
+
+	// 下一个请求的读不受影响
+	tr.resetAbort()
+	tr.setReadTimeout(50 * time.Millisecond)
+	start := time.Now()
+	_, err = tr.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
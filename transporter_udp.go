@@ -0,0 +1,147 @@
+package dlt645
+
+import (
+	"errors"
+	"github.com/expgo/factory"
+	"net"
+	"os"
+	"time"
+)
+
+// MaxDatagramSize the max size of the received datagram
+const MaxDatagramSize = 2048
+
+// UdpTransporter the transporter of the dtu which forward the meter data by udp, the frame may span the datagrams
+// or share one datagram, so the datagrams are read as a stream
+type UdpTransporter struct {
+	baseTransporter
+	LocalAddr    string                       // 本地地址, 为空时使用随机端口
+	SourceFilter func(addr *net.UDPAddr) bool // 数据报的源地址过滤, 为nil时只接收远端地址的数据报
+	conn         *net.UDPConn
+	remote       *net.UDPAddr
+	datagram     []byte
+	pending      []byte // 数据报中还没有读取的数据
+}
+
+func NewUdpTransport(addr string) *UdpTransporter {
+	return factory.NewBeforeInit[UdpTransporter](func(ret *UdpTransporter) {
+		ret.baseTransporter.addr = addr
+		ret.datagram = make([]byte, MaxDatagramSize)
+	})
+}
+
+func (t *UdpTransporter) Open() (err error) {
+	if !t.running.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	if t.state == StateConnected {
+		return nil
+	}
+
+	t.setState(StateConnecting, nil)
+
+	t.remote, err = net.ResolveUDPAddr("udp", t.addr)
+	if err == nil {
+		var local *net.UDPAddr
+		if len(t.LocalAddr) > 0 {
+			local, err = net.ResolveUDPAddr("udp", t.LocalAddr)
+		}
+		if err == nil {
+			t.conn, err = net.ListenUDP("udp", local)
+		}
+	}
+	if err != nil {
+		t.L.Warnf("Open udp %s failed: %v", t.addr, err)
+		t.setState(StateDisconnected, err)
+		return err
+	}
+
+	t.pending = nil
+	t.setAbortConn(t.conn)
+	t.setState(StateConnected, nil)
+
+	return err
+}
+
+func (t *UdpTransporter) Close() (err error) {
+	defer func() {
+		t.setState(StateConnectClosed, err)
+		t.conn = nil
+	}()
+
+	_ = t.baseTransporter.Close()
+	t.setAbortConn(nil)
+
+	if t.conn == nil {
+		return nil
+	}
+
+	return t.conn.Close()
+}
+
+func (t *UdpTransporter) Write(data []byte) (n int, err error) {
+	if t.conn == nil || t.state == StateDisconnected {
+		return 0, errors.New("udp transporter not connected")
+	}
+
+	defer func() {
+		if err != nil {
+			t.setState(StateDisconnected, err)
+		}
+	}()
+
+	err = t.conn.SetWriteDeadline(time.Now().Add(t.WriteTimeout))
+	if err != nil {
+		return 0, err
+	}
+
+	return t.conn.WriteToUDP(data, t.remote)
+}
+
+// accept return true if the datagram from the addr is accepted
+func (t *UdpTransporter) accept(addr *net.UDPAddr) bool {
+	if t.SourceFilter != nil {
+		return t.SourceFilter(addr)
+	}
+	return addr.IP.Equal(t.remote.IP) && addr.Port == t.remote.Port
+}
+
+func (t *UdpTransporter) Read(buf []byte) (n int, err error) {
+	if len(t.pending) > 0 {
+		n = copy(buf, t.pending)
+		t.pending = t.pending[n:]
+		return n, nil
+	}
+
+	if t.conn == nil || t.state == StateDisconnected {
+		return 0, errors.New("udp transporter not connected")
+	}
+
+	defer func() {
+		// 读超时是电表没有应答, 连接仍然可用
+		if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.setState(StateDisconnected, err)
+		}
+	}()
+
+	if err = t.armReadDeadline(t.conn); err != nil {
+		return 0, err
+	}
+
+	for {
+		m, addr, err := t.conn.ReadFromUDP(t.datagram)
+		if err != nil {
+			return 0, err
+		}
+
+		if !t.accept(addr) {
+			t.L.Debugf("discard datagram from %s: % X", addr, t.datagram[:m])
+			continue
+		}
+
+		n = copy(buf, t.datagram[:m])
+		t.pending = t.datagram[n:m]
+		return n, nil
+	}
+}
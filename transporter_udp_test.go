@@ -0,0 +1,67 @@
+package dlt645
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUdpTransporter_Read(t *testing.T) {
+	meter, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	assert.NoError(t, err)
+	defer meter.Close()
+
+	foreign, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	assert.NoError(t, err)
+	defer foreign.Close()
+
+	resp := testVoltageResponse()
+
+	// 模拟DTU: 其他地址的数据报被丢弃, 应答帧分成两个数据报
+	go func() {
+		req := make([]byte, MaxDatagramSize)
+		for {
+			_, addr, err := meter.ReadFromUDP(req)
+			if err != nil {
+				return
+			}
+			_, _ = foreign.WriteToUDP(resp, addr)
+			_, _ = meter.WriteToUDP(resp[:7], addr)
+			_, _ = meter.WriteToUDP(resp[7:], addr)
+		}
+	}()
+
+	tr := NewUdpTransport(meter.LocalAddr().String())
+	assert.NoError(t, tr.Open())
+	defer tr.Close()
+	assert.Equal(t, StateConnected, tr.State())
+
+	c := NewClient(tr)
+	for i := 0; i < 2; i++ {
+		v := c.Read("1", DICPhaseAVoltage)
+		assert.NoError(t, v[0].Err)
+		assert.Equal(t, "230", v[0].Value.String())
+	}
+
+	// 一个数据报中的数据分多次读取
+	local := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: tr.conn.LocalAddr().(*net.UDPAddr).Port}
+	_, err = meter.WriteToUDP(resp, local)
+	assert.NoError(t, err)
+	buf := make([]byte, 4)
+	n, err := tr.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, resp[:4], buf[:n])
+	n, err = tr.Read(make([]byte, MaxDatagramSize))
+	assert.NoError(t, err)
+	assert.Equal(t, len(resp)-4, n)
+
+	// 读超时后仍然可用
+	tr.setReadTimeout(20 * time.Millisecond)
+	_, err = tr.Read(buf)
+	assert.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+	assert.Equal(t, StateConnected, tr.State())
+}
@@ -3,6 +3,7 @@ package dlt645
 import (
 	"fmt"
 	"math"
+	"time"
 )
 
 func decimalDigits(value uint64) int {
@@ -60,3 +61,37 @@ func bcdToUint(data []byte, len int) (ret uint64) {
 
 	return ret
 }
+
+// bcdToTime decode the YYMMDDhhmmss(6 bytes) or YYMMDDhhmm(5 bytes) data, the data is low byte first
+func bcdToTime(data []byte, loc *time.Location) (time.Time, error) {
+	if len(data) != 5 && len(data) != 6 {
+		return time.Time{}, fmt.Errorf("invalid time data length: %d", len(data))
+	}
+
+	var fields [6]int
+	for i := range data {
+		fields[6-len(data)+i] = int(bcdToUint(data[i:i+1], 1))
+	}
+
+	// 全0表示没有时间
+	if fields[5] == 0 && fields[4] == 0 && fields[3] == 0 {
+		return time.Time{}, nil
+	}
+
+	sec, min, hour, day, month, year := fields[0], fields[1], fields[2], fields[3], fields[4], fields[5]
+	if month < 1 || month > 12 || day < 1 || day > 31 || hour > 23 || min > 59 || sec > 59 {
+		return time.Time{}, fmt.Errorf("invalid time data: % X", data)
+	}
+
+	return time.Date(2000+year, time.Month(month), day, hour, min, sec, 0, loc), nil
+}
+
+// timeToBcd encode the time to YYMMDDhhmmss(6 bytes) or YYMMDDhhmm(5 bytes) data, the data is low byte first
+func timeToBcd(t time.Time, size int) []byte {
+	value := uint64(t.Year()%100)*1e10 + uint64(t.Month())*1e8 + uint64(t.Day())*1e6 +
+		uint64(t.Hour())*1e4 + uint64(t.Minute())*1e2 + uint64(t.Second())
+	if size == 5 {
+		value /= 100
+	}
+	return uintToBcd(value, size)
+}